@@ -0,0 +1,112 @@
+// Package analyzer defines an analysis.Analyzer that checks constant arguments of isoduration.ParseDuration
+// and isoduration.MustParseDuration at analysis time, so bad literals are found before MustParseDuration panics
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/MyBlackJay/isoduration"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+	"golang.org/x/tools/go/types/typeutil"
+)
+
+// packagePath is the import path of the checked functions
+const packagePath = "github.com/MyBlackJay/isoduration"
+
+// maxTimeDuration is the length of the longest time.Duration in seconds
+const maxTimeDuration = math.MaxInt64 / 1e9
+
+// checked defines the names of the checked functions
+var checked = map[string]bool{"ParseDuration": true, "MustParseDuration": true}
+
+// Analyzer reports constant arguments of ParseDuration and MustParseDuration that cannot be parsed, are not in the canonical
+// form returned by String, or overflow time.Duration. Non-canonical literals get suggested fixes with the canonical
+// ISO 8601 form, Go syntax literals such as 30s with the form returned by isoduration.FormatTimeDuration, as lint and
+// Migrate do
+var Analyzer = &analysis.Analyzer{
+	Name:     "isoduration",
+	Doc:      "check constant ISO 8601 duration literals passed to isoduration.ParseDuration and isoduration.MustParseDuration",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+// run checks the calls of the pass
+func run(pass *analysis.Pass) (any, error) {
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	in.Preorder([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node) {
+		call := n.(*ast.CallExpr)
+		fn := typeutil.StaticCallee(pass.TypesInfo, call)
+
+		if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != packagePath || !checked[fn.Name()] || len(call.Args) != 1 {
+			return
+		}
+
+		arg := call.Args[0]
+		tv, ok := pass.TypesInfo.Types[arg]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			return
+		}
+
+		check(pass, fn.Name(), arg, constant.StringVal(tv.Value))
+	})
+
+	return nil, nil
+}
+
+// check reports the problems of the literal value of arg passed to the function name
+func check(pass *analysis.Pass, name string, arg ast.Expr, value string) {
+	d, err := isoduration.ParseDuration(value)
+
+	if err != nil {
+		diagnostic := analysis.Diagnostic{
+			Pos:     arg.Pos(),
+			End:     arg.End(),
+			Message: name + ": invalid ISO 8601 duration " + strconv.Quote(value) + ": " + err.Error(),
+		}
+
+		if t, err := time.ParseDuration(value); err == nil {
+			diagnostic.Message += ", it is in Go syntax"
+			diagnostic.SuggestedFixes = replace(arg, isoduration.FormatTimeDuration(t))
+		}
+
+		pass.Report(diagnostic)
+		return
+	}
+
+	seconds := (((((d.Years()*isoduration.YearDays+d.Months()*isoduration.MonthDays+d.Weeks()*isoduration.WeekDays+d.Days())*
+		isoduration.DayHours)+d.Hours())*60)+d.Minutes())*60 + d.Seconds()
+
+	if math.Abs(seconds) > maxTimeDuration {
+		pass.Reportf(arg.Pos(), "%s: duration %q overflows time.Duration", name, value)
+	}
+
+	if canonical := d.String(); canonical != value {
+		pass.Report(analysis.Diagnostic{
+			Pos:            arg.Pos(),
+			End:            arg.End(),
+			Message:        name + ": duration " + strconv.Quote(value) + " is not in the canonical form " + strconv.Quote(canonical),
+			SuggestedFixes: replace(arg, canonical),
+		})
+	}
+}
+
+// replace returns a fix that replaces a string literal with value, other constant expressions are not fixed
+func replace(arg ast.Expr, value string) []analysis.SuggestedFix {
+	lit, ok := arg.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return nil
+	}
+
+	return []analysis.SuggestedFix{{
+		Message:   "Replace with " + strconv.Quote(value),
+		TextEdits: []analysis.TextEdit{{Pos: lit.Pos(), End: lit.End(), NewText: []byte(strconv.Quote(value))}},
+	}}
+}
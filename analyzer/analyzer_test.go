@@ -0,0 +1,15 @@
+package analyzer
+
+import (
+	"testing"
+
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzer(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Analyzer, "a")
+}
+
+func TestMigrate(t *testing.T) {
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), Migrate, "b")
+}
@@ -0,0 +1,21 @@
+// Command isodurationvet checks constant ISO 8601 duration literals passed to isoduration.ParseDuration
+// and isoduration.MustParseDuration. It runs standalone or as a vet tool:
+//
+//	isodurationvet ./...
+//	go vet -vettool=$(which isodurationvet) ./...
+//
+// It also migrates Go source: constant time.Duration values assigned to fields whose type became
+// *isoduration.Duration are rewritten into ISO 8601 literals. Print a diff, or rewrite the files in place:
+//
+//	isodurationvet -isodurationmigrate -fix -diff ./...
+//	isodurationvet -isodurationmigrate -fix ./...
+package main
+
+import (
+	"github.com/MyBlackJay/isoduration/analyzer"
+	"golang.org/x/tools/go/analysis/multichecker"
+)
+
+func main() {
+	multichecker.Main(analyzer.Analyzer, analyzer.Migrate)
+}
@@ -0,0 +1,13 @@
+module github.com/MyBlackJay/isoduration/analyzer
+
+go 1.25.0
+
+require (
+	github.com/MyBlackJay/isoduration v0.0.0
+	golang.org/x/tools v0.45.0
+)
+
+require (
+	golang.org/x/mod v0.36.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
+)
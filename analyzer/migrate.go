@@ -0,0 +1,137 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strconv"
+	"time"
+
+	"github.com/MyBlackJay/isoduration"
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+// Migrate reports constant time.Duration values, such as 30 * time.Second, assigned to fields and variables
+// of type *isoduration.Duration or isoduration.Duration, as happens after the type of a field is changed.
+// The suggested fixes replace the values with MustParseDuration and the ISO 8601 form returned by
+// isoduration.FormatTimeDuration. It runs despite type errors, since such assignments do not compile.
+// Fixes are suggested only in files that import isoduration
+var Migrate = &analysis.Analyzer{
+	Name:             "isodurationmigrate",
+	Doc:              "rewrite constant time.Duration values assigned to isoduration.Duration fields and variables into ISO 8601 literals",
+	Requires:         []*analysis.Analyzer{inspect.Analyzer},
+	RunDespiteErrors: true,
+	Run:              runMigrate,
+}
+
+// runMigrate checks the composite literals and assignments of the pass
+func runMigrate(pass *analysis.Pass) (any, error) {
+	in := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+
+	in.WithStack([]ast.Node{(*ast.KeyValueExpr)(nil), (*ast.AssignStmt)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+
+		file := stack[0].(*ast.File)
+
+		switch n := n.(type) {
+		case *ast.KeyValueExpr:
+			if key, ok := n.Key.(*ast.Ident); ok {
+				if field, ok := pass.TypesInfo.ObjectOf(key).(*types.Var); ok && field.IsField() {
+					migrate(pass, file, field.Type(), n.Value)
+				}
+			}
+		case *ast.AssignStmt:
+			if n.Tok == token.ASSIGN && len(n.Lhs) == len(n.Rhs) {
+				for i, lhs := range n.Lhs {
+					migrate(pass, file, pass.TypesInfo.TypeOf(lhs), n.Rhs[i])
+				}
+			}
+		}
+
+		return true
+	})
+
+	return nil, nil
+}
+
+// migrate reports value if it is a constant time.Duration and target is a duration type of isoduration
+func migrate(pass *analysis.Pass, file *ast.File, target types.Type, value ast.Expr) {
+	pointer := false
+	if p, ok := target.(*types.Pointer); ok {
+		target, pointer = p.Elem(), true
+	}
+
+	if !isNamed(target, packagePath, "Duration") {
+		return
+	}
+
+	tv, ok := pass.TypesInfo.Types[value]
+	if !ok || tv.Value == nil || !isNamed(tv.Type, "time", "Duration") {
+		return
+	}
+
+	v, exact := constant.Int64Val(constant.ToInt(tv.Value))
+	if !exact {
+		return
+	}
+
+	iso := isoduration.FormatTimeDuration(time.Duration(v))
+	diagnostic := analysis.Diagnostic{
+		Pos:     value.Pos(),
+		End:     value.End(),
+		Message: "time.Duration value assigned to isoduration.Duration, it is " + strconv.Quote(iso) + " in ISO 8601 format",
+	}
+
+	if name := importName(file, packagePath); name != "" {
+		text := name + ".MustParseDuration(" + strconv.Quote(iso) + ")"
+		if !pointer {
+			text = "*" + text
+		}
+
+		diagnostic.SuggestedFixes = []analysis.SuggestedFix{{
+			Message:   "Replace with " + text,
+			TextEdits: []analysis.TextEdit{{Pos: value.Pos(), End: value.End(), NewText: []byte(text)}},
+		}}
+	}
+
+	pass.Report(diagnostic)
+}
+
+// isNamed checks whether t is the named type name of the package path
+func isNamed(t types.Type, path, name string) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Pkg() != nil && obj.Pkg().Path() == path && obj.Name() == name
+}
+
+// importName returns the name the file imports the package path with, or an empty string if it is not imported
+// or is imported with the blank or dot name
+func importName(file *ast.File, path string) string {
+	for _, spec := range file.Imports {
+		if p, err := strconv.Unquote(spec.Path.Value); err != nil || p != path {
+			continue
+		}
+
+		if spec.Name == nil {
+			return "isoduration"
+		}
+
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+
+		return spec.Name.Name
+	}
+
+	return ""
+}
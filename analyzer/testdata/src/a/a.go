@@ -0,0 +1,16 @@
+package a
+
+import "github.com/MyBlackJay/isoduration"
+
+const timeout = "PT1H0M"
+
+func durations(s string) {
+	isoduration.MustParseDuration("PT1H30M")
+	isoduration.MustParseDuration("30s")   // want `MustParseDuration: invalid ISO 8601 duration "30s": .*, it is in Go syntax`
+	isoduration.MustParseDuration("48h")   // want `MustParseDuration: invalid ISO 8601 duration "48h": .*, it is in Go syntax`
+	isoduration.MustParseDuration("P1X")   // want `MustParseDuration: invalid ISO 8601 duration "P1X"`
+	isoduration.ParseDuration("PT0H30M")   // want `ParseDuration: duration "PT0H30M" is not in the canonical form "PT30M"`
+	isoduration.ParseDuration(timeout)     // want `ParseDuration: duration "PT1H0M" is not in the canonical form "PT1H"`
+	isoduration.MustParseDuration("P300Y") // want `MustParseDuration: duration "P300Y" overflows time.Duration`
+	isoduration.ParseDuration(s)
+}
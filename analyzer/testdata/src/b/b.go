@@ -0,0 +1,35 @@
+package b
+
+import (
+	"time"
+
+	iso "github.com/MyBlackJay/isoduration"
+)
+
+type Config struct {
+	Timeout  *iso.Duration
+	Interval iso.Duration
+	Retries  int
+	Backoff  time.Duration
+}
+
+const retryDelay = 90 * time.Second
+
+func New() *Config {
+	c := &Config{
+		Timeout:  30 * time.Second, // want `time.Duration value assigned to isoduration.Duration, it is "PT30S" in ISO 8601 format`
+		Interval: 36 * time.Hour,   // want `time.Duration value assigned to isoduration.Duration, it is "P1DT12H" in ISO 8601 format`
+		Retries:  3,
+		Backoff:  time.Second,
+	}
+
+	c.Timeout = retryDelay       // want `time.Duration value assigned to isoduration.Duration, it is "PT1M30S" in ISO 8601 format`
+	c.Timeout = -720 * time.Hour // want `time.Duration value assigned to isoduration.Duration, it is "-P1M" in ISO 8601 format`
+	c.Backoff = 2 * time.Second
+
+	var d time.Duration = time.Minute
+	c.Timeout = iso.MustParseDuration("PT1M")
+	c.Backoff = d
+
+	return c
+}
@@ -0,0 +1,8 @@
+// Package isoduration is a stub of the checked package for the analyzer tests
+package isoduration
+
+type Duration struct{}
+
+func ParseDuration(duration string) (*Duration, error) { return nil, nil }
+
+func MustParseDuration(duration string) *Duration { return nil }
@@ -4,6 +4,21 @@ import (
 	"testing"
 )
 
+// sinks keep the results of benchmarks, so the compiler does not optimize the calls away
+var (
+	stringSink string
+	bytesSink  []byte
+)
+
+// assertAllocs fails the benchmark if f allocates more than allocs times per run
+func assertAllocs(b *testing.B, allocs float64, f func()) {
+	b.Helper()
+
+	if n := testing.AllocsPerRun(100, f); n > allocs {
+		b.Fatalf("expected at most %v allocs/op, got %v", allocs, n)
+	}
+}
+
 func BenchmarkParseDuration(b *testing.B) {
 	str := "P30Y11.9M29.5D29.1WT10H30.5M5S"
 	for i := 0; i < b.N; i++ {
@@ -14,3 +29,88 @@ func BenchmarkParseDuration(b *testing.B) {
 		v.ToTimeDuration()
 	}
 }
+
+func BenchmarkParseInto(b *testing.B) {
+	str := "P30Y11.9M29.5D29.1WT10H30.5M5S"
+	var d Duration
+
+	f := func() {
+		if err := ParseInto(&d, str); err != nil {
+			panic(err)
+		}
+	}
+
+	assertAllocs(b, 0, f)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
+
+func BenchmarkParseBytes(b *testing.B) {
+	str := []byte("-PT10H30.5M5S")
+
+	f := func() {
+		if _, err := ParseBytes(str); err != nil {
+			panic(err)
+		}
+	}
+
+	assertAllocs(b, 0, f)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
+
+func BenchmarkString(b *testing.B) {
+	d := MustParseDuration("P30Y11.9M29.5D29.1WT10H30.5M5S")
+
+	f := func() { stringSink = d.String() }
+
+	assertAllocs(b, 1, f)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
+
+func BenchmarkAppendFormat(b *testing.B) {
+	d := MustParseDuration("P30Y11.9M29.5D29.1WT10H30.5M5S")
+	buffer := make([]byte, 0, 64)
+
+	f := func() { buffer = d.AppendFormat(buffer[:0]) }
+
+	assertAllocs(b, 0, f)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
+
+func BenchmarkMarshalJSON(b *testing.B) {
+	d := MustParseDuration("-PT10H30.5M5S")
+
+	f := func() {
+		var err error
+		if bytesSink, err = d.MarshalJSON(); err != nil {
+			panic(err)
+		}
+	}
+
+	assertAllocs(b, 1, f)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		f()
+	}
+}
@@ -0,0 +1,129 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// cassandraFormat is the format name used in errors
+const cassandraFormat = "Cassandra"
+
+// Cassandra units
+var (
+	// cassandraUnits defines the units accepted by CQL duration literals in their required order, they are case-insensitive
+	cassandraUnits = []unitMark{
+		{"y", yearsMark, 1},
+		{"mo", monthsMark, 1},
+		{"w", weeksMark, 1},
+		{"d", daysMark, 1},
+		{"h", hoursMark, 1},
+		{"m", minutesMark, 1},
+		{"s", secondsMark, 1},
+		{"ms", secondsMark, 1e-3},
+		{"us", secondsMark, 1e-6},
+		{"µs", secondsMark, 1e-6},
+		{"ns", secondsMark, 1e-9},
+	}
+	// cassandraSizes defines the units used to format the nanoseconds of CQL duration literals
+	cassandraSizes = []unitSize{
+		{"h", 1e9 * 60 * 60, false},
+		{"m", 1e9 * 60, false},
+		{"s", 1e9, false},
+		{"ms", 1e6, false},
+		{"us", 1e3, false},
+		{"ns", 1, false},
+	}
+)
+
+// ParseCassandra parses a CQL duration literal: integer values with the units y, mo, w, d, h, m, s, ms, us or µs and ns
+// in this order, the ISO 8601 format or the ISO 8601 alternative format, with an optional leading minus sign.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1y2mo3d4h, -89h4m48s, P1Y2M3DT4H or P0001-02-03T04:05:06
+func ParseCassandra(duration string) (*Duration, error) {
+	str, isNegative := cutSign(duration)
+
+	switch {
+	case !strings.HasPrefix(str, string(PERIOD)):
+		return parseOrderedUnits(duration, cassandraFormat, cassandraUnits, true)
+	case !strings.ContainsRune(str, '-'):
+		return ParseDuration(duration)
+	}
+
+	// the alternative format P[YYYY]-[MM]-[DD]T[hh]:[mm]:[ss]
+	date, clock, ok := strings.Cut(str[1:], string(TIME))
+	fields := append(strings.Split(date, "-"), strings.Split(clock, ":")...)
+
+	if !ok || len(fields) != 6 {
+		return nil, NewIncorrectFormatError(cassandraFormat, duration)
+	}
+
+	var marks [6]float64
+	for i, f := range fields {
+		v, err := strconv.ParseUint(f, 10, 63)
+		if err != nil {
+			return nil, NewIncorrectFormatError(cassandraFormat, duration)
+		}
+		marks[i] = float64(v)
+	}
+
+	return NewDuration(marks[0], marks[1], marks[2], 0, marks[3], marks[4], marks[5], isNegative), nil
+}
+
+// cassandraValue returns *Duration as the Cassandra duration value: months, days and nanoseconds.
+// Returns an error if months or days are fractional, are out of the int32 range, or the values have different signs
+func (d *Duration) cassandraValue() (months, days, nanos int64, err error) {
+	m := (d.period.years*12 + d.period.months) * d.multiplier
+	dd := (d.period.weeks*WeekDays + d.period.days) * d.multiplier
+	ns := math.Round((d.time.hours*3600 + d.time.minutes*60 + d.time.seconds) * 1e9 * d.multiplier)
+
+	switch {
+	case m != math.Trunc(m):
+		return 0, 0, 0, NewUnsupportedDesignatorError(cassandraFormat, MONTH)
+	case dd != math.Trunc(dd):
+		return 0, 0, 0, NewUnsupportedDesignatorError(cassandraFormat, DAY)
+	case math.Abs(m) > math.MaxInt32 || math.Abs(dd) > math.MaxInt32 || math.Abs(ns) >= math.MaxInt64:
+		return 0, 0, 0, DurationOverflowError
+	case (m < 0 || dd < 0 || ns < 0) && (m > 0 || dd > 0 || ns > 0):
+		return 0, 0, 0, MixedSignsError
+	}
+
+	return int64(m), int64(dd), int64(ns), nil
+}
+
+// FormatCassandra represents *Duration as a CQL duration literal the way Cassandra does, keeping months, days and
+// nanoseconds separately: years and months are normalized into y and mo, weeks into days.
+// Returns an error if the duration cannot be represented as a Cassandra duration
+// For example: 1y2mo3d4h
+func (d *Duration) FormatCassandra() (string, error) {
+	months, days, nanos, err := d.cassandraValue()
+	if err != nil {
+		return "", err
+	}
+
+	b := strings.Builder{}
+
+	if months < 0 || days < 0 || nanos < 0 {
+		b.WriteByte('-')
+	}
+
+	months, days, nanos = abs(months), abs(days), abs(nanos)
+
+	if months == 0 && days == 0 && nanos == 0 {
+		return "0s", nil
+	}
+
+	if months >= 12 {
+		b.WriteString(strconv.FormatInt(months/12, 10) + "y")
+	}
+	if months%12 != 0 {
+		b.WriteString(strconv.FormatInt(months%12, 10) + "mo")
+	}
+	if days != 0 {
+		b.WriteString(strconv.FormatInt(days, 10) + "d")
+	}
+
+	b.WriteString(formatUnits(nanos, cassandraSizes, ""))
+
+	return b.String(), nil
+}
@@ -0,0 +1,101 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseCassandra(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1y2mo3d4h",
+			result: NewDuration(1, 2, 3, 0, 4, 0, 0, false),
+		},
+		{
+			input:  "-89H4m48S500MS",
+			result: NewDuration(0, 0, 0, 0, 89, 4, 48.5, true),
+		},
+		{
+			input:  "P1Y2M3DT4H",
+			result: NewDuration(1, 2, 3, 0, 4, 0, 0, false),
+		},
+		{
+			input:  "-P0001-02-03T04:05:06",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, true),
+		},
+		{
+			input:   "1d1y",
+			isError: true,
+			err:     NewIncorrectFormatError(cassandraFormat, "1y"),
+		},
+		{
+			input:   "P0001-02-03",
+			isError: true,
+			err:     NewIncorrectFormatError(cassandraFormat, "P0001-02-03"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseCassandra(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatCassandra(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 0, 0, false),
+			result: "1y2mo3d4h",
+		},
+		{
+			input:  NewDuration(0, 14, 1, 1, 0, 90, 0.0015, true),
+			result: "-1y2mo8d1h30m1ms500us",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "0s",
+		},
+		{
+			input:   NewDuration(0, 0.5, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(cassandraFormat, MONTH),
+		},
+		{
+			input:   NewDuration(-1, 0, 1, 0, 0, 0, 0, false),
+			isError: true,
+			err:     MixedSignsError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatCassandra()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
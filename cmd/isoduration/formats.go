@@ -0,0 +1,39 @@
+package main
+
+import (
+	"github.com/MyBlackJay/isoduration"
+)
+
+// format defines how a duration format is parsed and formatted, a nil function means the format is one way only
+type format struct {
+	parse  func(string) (*isoduration.Duration, error)
+	format func(*isoduration.Duration) (string, error)
+}
+
+// formats defines the formats of the convert command by name
+var formats = map[string]format{
+	"iso": {isoduration.ParseDuration, func(d *isoduration.Duration) (string, error) { return d.String(), nil }},
+	"any": {isoduration.ParseAny, nil},
+	"go":  {isoduration.ParseAny, (*isoduration.Duration).FormatGo},
+	"human": {isoduration.ParseHuman, func(d *isoduration.Duration) (string, error) {
+		return d.Humanize(), nil
+	}},
+	"java":       {isoduration.ParseJavaDuration, (*isoduration.Duration).FormatJavaDuration},
+	"javaperiod": {isoduration.ParseJavaPeriod, (*isoduration.Duration).FormatJavaPeriod},
+	"xsd": {
+		func(s string) (*isoduration.Duration, error) {
+			return isoduration.ParseXSDDuration(s, isoduration.XSDDuration)
+		},
+		func(d *isoduration.Duration) (string, error) { return d.FormatXSD(isoduration.XSDDuration) },
+	},
+	"icalendar": {isoduration.ParseICalendar, (*isoduration.Duration).FormatICalendar},
+	"postgres": {isoduration.ParsePostgresInterval, func(d *isoduration.Duration) (string, error) {
+		return d.FormatPostgresInterval(isoduration.IntervalStylePostgres), nil
+	}},
+	"prometheus": {isoduration.ParsePrometheus, (*isoduration.Duration).FormatPrometheus},
+	"systemd":    {isoduration.ParseSystemd, (*isoduration.Duration).FormatSystemd},
+	"cassandra":  {isoduration.ParseCassandra, (*isoduration.Duration).FormatCassandra},
+	"timespan":   {isoduration.ParseTimeSpan, (*isoduration.Duration).FormatTimeSpan},
+	"timedelta":  {isoduration.ParseTimedelta, (*isoduration.Duration).FormatTimedelta},
+	"protojson":  {isoduration.ParseProtoJSON, (*isoduration.Duration).FormatProtoJSON},
+}
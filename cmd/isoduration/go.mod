@@ -0,0 +1,10 @@
+module github.com/MyBlackJay/isoduration/cmd/isoduration
+
+go 1.21
+
+require (
+	github.com/MyBlackJay/isoduration v0.0.0
+	github.com/MyBlackJay/isoduration/lint v0.0.0
+)
+
+require gopkg.in/yaml.v3 v3.0.1 // indirect
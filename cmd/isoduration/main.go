@@ -0,0 +1,449 @@
+// Command isoduration parses, converts and humanizes durations in ISO 8601 format and does date arithmetic with them.
+//
+// Usage:
+//
+//	isoduration parse [-json] DURATION...
+//	isoduration add [-json] TIME DURATION...
+//	isoduration diff [-json] FROM TO...
+//	isoduration convert [-json] [-from FORMAT] [-to FORMAT] DURATION...
+//	isoduration humanize [-json] [-short] [-max N] [-round] [-locale TAG] DURATION...
+//	isoduration lint [-json] [-path PATH]... [-key PATTERN]... [-min DURATION] [-max DURATION] FILE...
+//	isoduration migrate [-json] [-w] [-path PATH]... [-key PATTERN]... FILE...
+//
+// Without arguments the input is read from stdin line by line. Flags go before the arguments,
+// use -- before negative durations, for example: isoduration parse -- -PT1H
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/MyBlackJay/isoduration"
+	"github.com/MyBlackJay/isoduration/lint"
+)
+
+// timeLayouts are the accepted time layouts, the result of add keeps the layout of its input
+var timeLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05", "2006-01-02"}
+
+// options holds the flags of all commands
+type options struct {
+	json   bool
+	from   string
+	to     string
+	short  bool
+	max    int
+	round  bool
+	locale string
+	lint   lintOptions
+	write  bool
+}
+
+// command defines a subcommand: the number of input fields, its flags and how an input is processed
+type command struct {
+	fields int
+	flags  func(fs *flag.FlagSet, o *options)
+	exec   func(o *options, fields []string) (result any, text string, err error)
+}
+
+// commands defines the subcommands by name
+var commands = map[string]command{
+	"parse":    {fields: 1, exec: execParse},
+	"add":      {fields: 2, exec: execAdd},
+	"diff":     {fields: 2, exec: execDiff},
+	"convert":  {fields: 1, flags: convertFlags, exec: execConvert},
+	"humanize": {fields: 1, flags: humanizeFlags, exec: execHumanize},
+	"lint":     {fields: 1, flags: lintFlags, exec: execLint},
+	"migrate":  {fields: 1, flags: migrateFlags, exec: execMigrate},
+}
+
+// errFindings is returned by commands whose output is written, but the exit code must show that problems were found
+var errFindings = errors.New("problems found")
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// usage writes the usage message
+func usage(w io.Writer) {
+	names := make([]string, 0, len(formats))
+	for name := range formats {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Fprintln(w, "usage: isoduration parse|add|diff|convert|humanize|lint|migrate [flags] [arguments]")
+	fmt.Fprintln(w, "formats of convert: "+strings.Join(names, ", "))
+}
+
+// run executes the command line args and returns the exit code: 0 on success, 1 if an input failed and 2 on usage errors
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 {
+		usage(stderr)
+		return 2
+	}
+
+	cmd, ok := commands[args[0]]
+	if !ok {
+		usage(stderr)
+		return 2
+	}
+
+	o := &options{from: "any", to: "iso"}
+	fs := flag.NewFlagSet("isoduration "+args[0], flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	fs.BoolVar(&o.json, "json", false, "write results as JSON lines")
+
+	if cmd.flags != nil {
+		cmd.flags(fs, o)
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return 2
+	}
+
+	inputs, err := readInputs(fs.Args(), cmd.fields, stdin)
+	if err != nil {
+		fmt.Fprintln(stderr, "isoduration:", err)
+		return 2
+	}
+
+	code := 0
+	encoder := json.NewEncoder(stdout)
+
+	for _, fields := range inputs {
+		result, text, err := cmd.exec(o, fields)
+
+		if errors.Is(err, errFindings) {
+			err, code = nil, 1
+		}
+
+		switch {
+		case err != nil:
+			fmt.Fprintf(stderr, "isoduration: %s: %v\n", strings.Join(fields, " "), err)
+			code = 1
+		case o.json:
+			if err := encoder.Encode(result); err != nil {
+				fmt.Fprintln(stderr, "isoduration:", err)
+				return 1
+			}
+		case text != "":
+			fmt.Fprintln(stdout, text)
+		}
+	}
+
+	return code
+}
+
+// readInputs groups args by the number of fields, or reads the inputs from stdin line by line if there are no args.
+// A line is a single field if the command takes one, so human-readable durations may contain spaces
+func readInputs(args []string, fields int, stdin io.Reader) ([][]string, error) {
+	var inputs [][]string
+
+	if len(args) > 0 {
+		if len(args)%fields != 0 {
+			return nil, fmt.Errorf("expected arguments in groups of %d, got %d", fields, len(args))
+		}
+
+		for i := 0; i < len(args); i += fields {
+			inputs = append(inputs, args[i:i+fields])
+		}
+
+		return inputs, nil
+	}
+
+	scanner := bufio.NewScanner(stdin)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch parts := strings.Fields(line); {
+		case line == "":
+			continue
+		case fields == 1:
+			inputs = append(inputs, []string{line})
+		case len(parts) == fields:
+			inputs = append(inputs, parts)
+		default:
+			return nil, fmt.Errorf("expected %d fields in line %q", fields, line)
+		}
+	}
+
+	return inputs, scanner.Err()
+}
+
+// parseResult is the JSON output of parse
+type parseResult struct {
+	Input       string  `json:"input"`
+	ISO         string  `json:"iso"`
+	Years       float64 `json:"years"`
+	Months      float64 `json:"months"`
+	Weeks       float64 `json:"weeks"`
+	Days        float64 `json:"days"`
+	Hours       float64 `json:"hours"`
+	Minutes     float64 `json:"minutes"`
+	Seconds     float64 `json:"seconds"`
+	Negative    bool    `json:"negative"`
+	Exact       bool    `json:"exact"`
+	Nanoseconds *int64  `json:"nanoseconds,omitempty"`
+	Length      string  `json:"length,omitempty"`
+}
+
+// positiveZero turns -0, as the getters of negative durations return zero components, into 0
+func positiveZero(v float64) float64 {
+	if v == 0 {
+		return 0
+	}
+
+	return v
+}
+
+// execParse shows the components of a duration and its exact length if it has no years and months
+func execParse(_ *options, fields []string) (any, string, error) {
+	d, err := isoduration.ParseDuration(fields[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	r := parseResult{
+		Input:    fields[0],
+		ISO:      d.String(),
+		Years:    positiveZero(d.Years()),
+		Months:   positiveZero(d.Months()),
+		Weeks:    positiveZero(d.Weeks()),
+		Days:     positiveZero(d.Days()),
+		Hours:    positiveZero(d.Hours()),
+		Minutes:  positiveZero(d.Minutes()),
+		Seconds:  positiveZero(d.Seconds()),
+		Negative: strings.HasPrefix(d.String(), "-"),
+		Exact:    d.IsExact(),
+	}
+	length := "nominal"
+
+	if r.Exact {
+		ns := int64(d.ToTimeDuration())
+		r.Nanoseconds, r.Length, length = &ns, d.ToTimeDuration().String(), d.ToTimeDuration().String()
+	}
+
+	return r, fmt.Sprintf("%s years=%g months=%g weeks=%g days=%g hours=%g minutes=%g seconds=%g length=%s",
+		r.ISO, r.Years, r.Months, r.Weeks, r.Days, r.Hours, r.Minutes, r.Seconds, length), nil
+}
+
+// parseTime parses a time in one of timeLayouts and returns the layout it matched
+func parseTime(value string) (time.Time, string, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, layout, nil
+		}
+	}
+
+	return time.Time{}, "", fmt.Errorf("incorrect time %s, expected RFC 3339 or YYYY-MM-DD", value)
+}
+
+// addResult is the JSON output of add
+type addResult struct {
+	Time     string `json:"time"`
+	Duration string `json:"duration"`
+	Result   string `json:"result"`
+}
+
+// execAdd adds a duration to a time using calendar arithmetic
+func execAdd(_ *options, fields []string) (any, string, error) {
+	t, layout, err := parseTime(fields[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	d, err := isoduration.ParseDuration(fields[1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := d.AddTo(t).Format(layout)
+
+	return addResult{fields[0], d.String(), result}, result, nil
+}
+
+// diffResult is the JSON output of diff
+type diffResult struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Duration string `json:"duration"`
+}
+
+// execDiff shows the calendar difference between two times
+func execDiff(_ *options, fields []string) (any, string, error) {
+	from, _, err := parseTime(fields[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	to, _, err := parseTime(fields[1])
+	if err != nil {
+		return nil, "", err
+	}
+
+	d := isoduration.Between(from, to).String()
+
+	return diffResult{fields[0], fields[1], d}, d, nil
+}
+
+// convertFlags defines the flags of convert
+func convertFlags(fs *flag.FlagSet, o *options) {
+	fs.StringVar(&o.from, "from", o.from, "format of the input")
+	fs.StringVar(&o.to, "to", o.to, "format of the output")
+}
+
+// convertResult is the JSON output of convert
+type convertResult struct {
+	Input  string `json:"input"`
+	From   string `json:"from"`
+	To     string `json:"to"`
+	Result string `json:"result"`
+}
+
+// execConvert converts a duration from one format into another
+func execConvert(o *options, fields []string) (any, string, error) {
+	from, to := formats[o.from], formats[o.to]
+
+	switch {
+	case from.parse == nil:
+		return nil, "", errors.New("unknown input format " + o.from)
+	case to.format == nil:
+		return nil, "", errors.New("unknown output format " + o.to)
+	}
+
+	d, err := from.parse(fields[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	result, err := to.format(d)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return convertResult{fields[0], o.from, o.to, result}, result, nil
+}
+
+// humanizeFlags defines the flags of humanize
+func humanizeFlags(fs *flag.FlagSet, o *options) {
+	fs.BoolVar(&o.short, "short", false, "use short unit names")
+	fs.IntVar(&o.max, "max", 0, "maximum number of units, 0 means no limit")
+	fs.BoolVar(&o.round, "round", false, "round the last unit")
+	fs.StringVar(&o.locale, "locale", "en", "BCP 47 language tag")
+}
+
+// humanizeResult is the JSON output of humanize
+type humanizeResult struct {
+	Input  string `json:"input"`
+	Result string `json:"result"`
+}
+
+// execHumanize renders a duration as words
+func execHumanize(o *options, fields []string) (any, string, error) {
+	d, err := isoduration.ParseAny(fields[0])
+	if err != nil {
+		return nil, "", err
+	}
+
+	humanizeOptions := []isoduration.HumanizeOption{isoduration.WithLocale(o.locale), isoduration.WithMaxUnits(o.max)}
+	if o.short {
+		humanizeOptions = append(humanizeOptions, isoduration.WithShortUnits())
+	}
+	if o.round {
+		humanizeOptions = append(humanizeOptions, isoduration.WithRounding())
+	}
+
+	result := d.Humanize(humanizeOptions...)
+
+	return humanizeResult{fields[0], result}, result, nil
+}
+
+// listFlag is a flag that can be repeated, its values are collected in order
+type listFlag []string
+
+// String returns the values separated by commas
+func (l *listFlag) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set adds a value
+func (l *listFlag) Set(value string) error {
+	*l = append(*l, value)
+	return nil
+}
+
+// lintOptions holds the flags of lint
+type lintOptions struct {
+	paths listFlag
+	keys  listFlag
+	min   string
+	max   string
+}
+
+// lintFlags defines the flags of lint
+func lintFlags(fs *flag.FlagSet, o *options) {
+	fs.Var(&o.lint.paths, "path", "key path of values to check, for example jobs.*.interval, can be repeated")
+	fs.Var(&o.lint.keys, "key", "pattern of keys of values to check, for example *_timeout, can be repeated")
+	fs.StringVar(&o.lint.min, "min", "", "minimum duration")
+	fs.StringVar(&o.lint.max, "max", "", "maximum duration")
+}
+
+// lintResult is the JSON output of lint
+type lintResult struct {
+	File        string            `json:"file"`
+	Diagnostics []lint.Diagnostic `json:"diagnostics"`
+}
+
+// lintOptionsOf returns the options of lint.File set by the flags
+func lintOptionsOf(o *options) (lint.Options, error) {
+	options := lint.Options{Paths: o.lint.paths, Keys: o.lint.keys}
+
+	for _, v := range []struct {
+		value string
+		bound **isoduration.Duration
+	}{{o.lint.min, &options.Min}, {o.lint.max, &options.Max}} {
+		if v.value == "" {
+			continue
+		}
+
+		d, err := isoduration.ParseDuration(v.value)
+		if err != nil {
+			return options, err
+		}
+		*v.bound = d
+	}
+
+	return options, nil
+}
+
+// execLint checks the durations in a JSON or YAML file, diagnostics are written as file:line:column: path: message
+func execLint(o *options, fields []string) (any, string, error) {
+	options, err := lintOptionsOf(o)
+	if err != nil {
+		return nil, "", err
+	}
+
+	diagnostics, err := lint.File(fields[0], options)
+	if err != nil {
+		return nil, "", err
+	}
+
+	lines := make([]string, 0, len(diagnostics))
+	for _, d := range diagnostics {
+		lines = append(lines, d.String())
+	}
+
+	if len(diagnostics) != 0 {
+		err = errFindings
+	}
+
+	return lintResult{fields[0], append([]lint.Diagnostic{}, diagnostics...)}, strings.Join(lines, "\n"), err
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		args   []string
+		stdin  string
+		result string
+		code   int
+	}{
+		{
+			args:   []string{"parse", "P1Y2M", "PT1H30M"},
+			result: "P1Y2M years=1 months=2 weeks=0 days=0 hours=0 minutes=0 seconds=0 length=nominal\nPT1H30M years=0 months=0 weeks=0 days=0 hours=1 minutes=30 seconds=0 length=1h30m0s\n",
+		},
+		{
+			args:   []string{"parse", "-json", "--", "-PT1S"},
+			result: `{"input":"-PT1S","iso":"-PT1S","years":0,"months":0,"weeks":0,"days":0,"hours":0,"minutes":0,"seconds":-1,"negative":true,"exact":true,"nanoseconds":-1000000000,"length":"-1s"}` + "\n",
+		},
+		{args: []string{"add", "2024-01-31", "P1M"}, result: "2024-02-29\n"},
+		{args: []string{"add", "2024-01-31T10:00:00Z", "PT1H"}, result: "2024-01-31T11:00:00Z\n"},
+		{args: []string{"diff", "2024-01-01", "2024-03-15"}, result: "P2M14D\n"},
+		{
+			args:   []string{"diff", "-json", "2024-01-01", "2024-03-15"},
+			result: `{"from":"2024-01-01","to":"2024-03-15","duration":"P2M14D"}` + "\n",
+		},
+		{args: []string{"convert", "-from", "go", "1h30m"}, result: "PT1H30M\n"},
+		{args: []string{"convert", "-to", "go", "PT1H30M"}, result: "1h30m0s\n"},
+		{args: []string{"convert", "-from", "human", "-to", "systemd"}, stdin: "an hour and a half\n\n2 days\n", result: "1h 30min\n2d\n"},
+		{args: []string{"humanize", "PT90M"}, result: "90 minutes\n"},
+		{args: []string{"humanize", "-short", "-locale", "ru"}, stdin: "PT2H30M\n", result: "2 ч 30 мин\n"},
+		{args: []string{"parse", "1h", "PT1H"}, result: "PT1H years=0 months=0 weeks=0 days=0 hours=1 minutes=0 seconds=0 length=1h0m0s\n", code: 1},
+		{args: []string{"convert", "-to", "json", "PT1H"}, code: 1},
+		{args: []string{"add", "2024-01-31"}, code: 2},
+		{args: []string{"multiply"}, code: 2},
+		{args: nil, code: 2},
+	}
+
+	for i, v := range tests {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		switch code := run(v.args, strings.NewReader(v.stdin), stdout, stderr); {
+		case code == v.code && stdout.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.args)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %d %q. Result: %d %q, %s", i, v.args, v.code, v.result, code, stdout, stderr)
+		}
+	}
+}
+
+func TestLint(t *testing.T) {
+	dir := t.TempDir()
+	good, bad := filepath.Join(dir, "good.yaml"), filepath.Join(dir, "bad.yml")
+
+	if err := os.WriteFile(good, []byte("read_timeout: PT30S\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(bad, []byte("server:\n  read_timeout: PT30S\n  idle: PT2H\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args   []string
+		result string
+		code   int
+	}{
+		{args: []string{"lint", good}},
+		{args: []string{"lint", "-path", "server.idle", "-max", "PT1H", good, bad}, result: bad + ":3:9: server.idle: incorrect duration PT2H, the value is greater than the maximum PT1H\n", code: 1},
+		{args: []string{"lint", "-json", good}, result: `{"file":"` + good + `","diagnostics":[]}` + "\n"},
+		{args: []string{"lint", "-min", "1s", good}, code: 1},
+		{args: []string{"lint", filepath.Join(dir, "missing.json")}, code: 1},
+	}
+
+	for i, v := range tests {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+
+		switch code := run(v.args, strings.NewReader(""), stdout, stderr); {
+		case code == v.code && stdout.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.args)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %d %q. Result: %d %q, %s", i, v.args, v.code, v.result, code, stdout, stderr)
+		}
+	}
+}
+
+func TestMigrate(t *testing.T) {
+	dir := t.TempDir()
+	config := "# service\nserver:\n  read_timeout: 30s # per request\n  write_timeout: PT30S\n  a: 1\n  b: 2\n  c: 3\n  d: 4\n  e: 5\n  f: 6\n  g: 7\n  idle_timeout: \"1h30m\"\n"
+	name, json := filepath.Join(dir, "config.yaml"), filepath.Join(dir, "config.json")
+
+	if err := os.WriteFile(name, []byte(config), 0o640); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(json, []byte(`{"retry_interval": "250ms"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		args   []string
+		result string
+		file   string
+	}{
+		{
+			args: []string{"migrate", name},
+			result: "--- " + name + "\n+++ " + name + "\n" +
+				"@@ -1,6 +1,6 @@\n # service\n server:\n-  read_timeout: 30s # per request\n+  read_timeout: PT30S # per request\n   write_timeout: PT30S\n   a: 1\n   b: 2\n" +
+				"@@ -9,4 +9,4 @@\n   e: 5\n   f: 6\n   g: 7\n-  idle_timeout: \"1h30m\"\n+  idle_timeout: \"PT1H30M\"\n",
+			file: config,
+		},
+		{
+			args:   []string{"migrate", json},
+			result: "--- " + json + "\n+++ " + json + "\n@@ -1,1 +1,1 @@\n-{\"retry_interval\": \"250ms\"}\n\\ No newline at end of file\n+{\"retry_interval\": \"PT0.25S\"}\n\\ No newline at end of file\n",
+		},
+		{
+			args:   []string{"migrate", "-json", "-key", "read_timeout", name},
+			result: `{"file":"` + name + `","changes":[{"line":3,"column":17,"path":"server.read_timeout","value":"30s","iso":"PT30S"}],"written":false}` + "\n",
+			file:   config,
+		},
+		{
+			args: []string{"migrate", "-w", name},
+			file: strings.NewReplacer("30s #", "PT30S #", `"1h30m"`, `"PT1H30M"`).Replace(config),
+		},
+		{
+			args: []string{"migrate", name},
+			file: strings.NewReplacer("30s #", "PT30S #", `"1h30m"`, `"PT1H30M"`).Replace(config),
+		},
+	}
+
+	for i, v := range tests {
+		stdout, stderr := &bytes.Buffer{}, &bytes.Buffer{}
+		code := run(v.args, strings.NewReader(""), stdout, stderr)
+		data, _ := os.ReadFile(name)
+
+		switch {
+		case code == 0 && stdout.String() == v.result && (v.file == "" || string(data) == v.file):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.args)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %q. Result: %d %q %q, %s", i, v.args, v.result, code, stdout, data, stderr)
+		}
+	}
+
+	if info, err := os.Stat(name); err != nil || info.Mode().Perm() != 0o640 {
+		t.Errorf("the mode of the rewritten file is not kept: %v, %v", info, err)
+	}
+}
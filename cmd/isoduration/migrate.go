@@ -0,0 +1,136 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/MyBlackJay/isoduration/lint"
+)
+
+// diffContext is the number of unchanged lines around the changes in diffs
+const diffContext = 3
+
+// migrateFlags defines the flags of migrate
+func migrateFlags(fs *flag.FlagSet, o *options) {
+	fs.Var(&o.lint.paths, "path", "key path of values to rewrite, for example jobs.*.interval, can be repeated")
+	fs.Var(&o.lint.keys, "key", "pattern of keys of values to rewrite, for example *_timeout, can be repeated")
+	fs.BoolVar(&o.write, "w", false, "write the result to the file instead of printing a diff")
+}
+
+// migrateChange is a rewritten value in the JSON output of migrate
+type migrateChange struct {
+	Line   int    `json:"line"`
+	Column int    `json:"column"`
+	Path   string `json:"path"`
+	Value  string `json:"value"`
+	ISO    string `json:"iso"`
+}
+
+// migrateResult is the JSON output of migrate
+type migrateResult struct {
+	File    string          `json:"file"`
+	Changes []migrateChange `json:"changes"`
+	Written bool            `json:"written"`
+}
+
+// execMigrate rewrites the durations in the time.ParseDuration syntax in a JSON or YAML file into ISO 8601 format.
+// Comments and formatting are kept. A unified diff is written unless -w is set, then the file is rewritten in place
+func execMigrate(o *options, fields []string) (any, string, error) {
+	name := fields[0]
+
+	info, err := os.Stat(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, "", err
+	}
+
+	diagnostics, err := lint.Check(name, data, lint.Options{Paths: o.lint.paths, Keys: o.lint.keys})
+	if err != nil {
+		return nil, "", err
+	}
+
+	result := migrateResult{File: name, Changes: []migrateChange{}}
+	for _, d := range diagnostics {
+		if d.Fix != nil {
+			result.Changes = append(result.Changes, migrateChange{d.Line, d.Column, d.Path, d.Value, d.Fix.Text})
+		}
+	}
+
+	if len(result.Changes) == 0 {
+		return result, "", nil
+	}
+
+	migrated := lint.Apply(data, diagnostics)
+
+	if !o.write {
+		return result, unifiedDiff(name, string(data), string(migrated)), nil
+	}
+
+	if err := os.WriteFile(name, migrated, info.Mode().Perm()); err != nil {
+		return nil, "", err
+	}
+	result.Written = true
+
+	return result, "", nil
+}
+
+// unifiedDiff returns the unified diff of two texts with the same number of lines, as fixes replace values within lines
+func unifiedDiff(name, old, new string) string {
+	a, b := strings.SplitAfter(old, "\n"), strings.SplitAfter(new, "\n")
+	if len(a) != len(b) {
+		return ""
+	}
+
+	var changed []int
+	for i := range a {
+		if a[i] != b[i] {
+			changed = append(changed, i)
+		}
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", name, name)
+
+	for i := 0; i < len(changed); {
+		// a hunk takes the following changes while their contexts touch
+		j := i
+		for j+1 < len(changed) && changed[j+1]-changed[j] <= 2*diffContext+1 {
+			j++
+		}
+
+		start, end := max(changed[i]-diffContext, 0), min(changed[j]+diffContext+1, len(a))
+		if a[end-1] == "" {
+			end--
+		}
+
+		fmt.Fprintf(&sb, "@@ -%d,%d +%d,%d @@\n", start+1, end-start, start+1, end-start)
+
+		for k := start; k < end; k++ {
+			switch {
+			case a[k] == b[k]:
+				sb.WriteString(" " + withNewline(a[k]))
+			default:
+				sb.WriteString("-" + withNewline(a[k]) + "+" + withNewline(b[k]))
+			}
+		}
+
+		i = j + 1
+	}
+
+	return strings.TrimSuffix(sb.String(), "\n")
+}
+
+// withNewline terminates the last line of a text that has no newline at the end
+func withNewline(line string) string {
+	if strings.HasSuffix(line, "\n") {
+		return line
+	}
+
+	return line + "\n\\ No newline at end of file\n"
+}
@@ -0,0 +1,217 @@
+package isoduration
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// durationType is the reflect type of Duration used to find Duration and *Duration struct fields
+var durationType = reflect.TypeOf(Duration{})
+
+// walkFields calls fn for each exported field of the struct pointed to by v that has the tag key, with the path of the field
+// and the field set to *Duration, allocated if it is a nil *Duration. Nested structs and non-nil pointers to structs are walked
+// recursively, as are embedded structs of unexported types, whose exported fields are promoted. A pointer that was already
+// walked is skipped, so cyclic structs are walked once. The errors of all fields are joined, each wrapped in FieldError by fieldErrors
+func walkFields(v any, key string, fn func(d *Duration, tag string) error) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return InvalidTargetError
+	}
+
+	visited := map[visit]bool{{rv.Pointer(), rv.Type()}: true}
+
+	return errors.Join(walkStruct(rv.Elem(), "", key, fn, visited)...)
+}
+
+// visit is a pointer to a struct walked by walkStruct, the type tells apart a struct and its first field
+type visit struct {
+	pointer uintptr
+	typ     reflect.Type
+}
+
+// walkStruct walks the fields of the struct value s for walkFields
+func walkStruct(s reflect.Value, prefix, key string, fn func(d *Duration, tag string) error, visited map[visit]bool) []error {
+	var errs []error
+
+	for i := 0; i < s.NumField(); i++ {
+		field, value := s.Type().Field(i), s.Field(i)
+		path := prefix + field.Name
+		tag, hasTag := field.Tag.Lookup(key)
+
+		if !field.IsExported() {
+			if field.Anonymous {
+				errs = append(errs, walkNested(value, path, key, fn, visited)...)
+			}
+			continue
+		}
+
+		switch {
+		case hasTag && field.Type == durationType:
+			errs = append(errs, fieldErrors(path, fn(value.Addr().Interface().(*Duration), tag))...)
+		case hasTag && field.Type == reflect.PointerTo(durationType):
+			d := value.Interface().(*Duration)
+			if d == nil {
+				d = new(Duration)
+			}
+
+			err := fn(d, tag)
+			errs = append(errs, fieldErrors(path, err)...)
+
+			if err == nil && value.IsNil() && !d.isEmpty() {
+				value.Set(reflect.ValueOf(d))
+			}
+		case hasTag:
+			errs = append(errs, NewFieldError(path, NewIncorrectTagError(tag)))
+		default:
+			errs = append(errs, walkNested(value, path, key, fn, visited)...)
+		}
+	}
+
+	return errs
+}
+
+// walkNested walks value for walkStruct if it is a struct or a non-nil pointer to a struct that was not walked yet,
+// Duration is not walked
+func walkNested(value reflect.Value, path, key string, fn func(d *Duration, tag string) error, visited map[visit]bool) []error {
+	if value.Kind() == reflect.Pointer && !value.IsNil() {
+		v := visit{value.Pointer(), value.Type()}
+		if visited[v] {
+			return nil
+		}
+
+		visited[v] = true
+		value = value.Elem()
+	}
+
+	if value.Kind() != reflect.Struct || value.Type() == durationType {
+		return nil
+	}
+
+	return walkStruct(value, path+".", key, fn, visited)
+}
+
+// fieldErrors wraps err in FieldError with the path, joined errors are wrapped one by one
+func fieldErrors(path string, err error) []error {
+	var errs []error
+
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			errs = append(errs, NewFieldError(path, e))
+		}
+	} else if err != nil {
+		errs = append(errs, NewFieldError(path, err))
+	}
+
+	return errs
+}
+
+// parseTag splits a tag into options: key=value pairs and flags without a value, only the keys from allowed are accepted
+func parseTag(tag string, allowed ...string) (map[string]string, error) {
+	options := map[string]string{}
+
+	for _, option := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+
+		found := false
+		for _, v := range allowed {
+			found = found || v == key
+		}
+
+		if _, ok := options[key]; !found || ok {
+			return nil, NewIncorrectTagError(tag)
+		}
+
+		options[key] = value
+	}
+
+	return options, nil
+}
+
+// tagBounds parses the min and max options in ISO 8601 duration format into a Range rule, nil if neither is set.
+// Returns IncorrectTagError if a bound could not be parsed
+func tagBounds(tag string, options map[string]string) (Rule, error) {
+	var bounds [2]*Duration
+
+	for i, key := range [2]string{"min", "max"} {
+		if value, ok := options[key]; ok {
+			bound, err := ParseDuration(value)
+			if err != nil {
+				return nil, NewIncorrectTagError(tag)
+			}
+			bounds[i] = bound
+		}
+	}
+
+	if bounds[0] == nil && bounds[1] == nil {
+		return nil, nil
+	}
+
+	return Range(bounds[0], bounds[1]), nil
+}
+
+// LoadEnv populates the Duration and *Duration fields of the struct pointed to by v from environment variables
+// using the iso struct tag. The tag options are:
+//
+//	env=NAME      the name of the environment variable, required
+//	default=PT30S the value used if the variable is not set or empty
+//	min=PT1S      the minimum value
+//	max=P1D       the maximum value
+//	required      the variable must be set if there is no default
+//
+// Fields without a value and a default are left as they are. Nested structs and non-nil pointers to structs are loaded too,
+// as are embedded structs of unexported types, a pointer met again in a cyclic struct is loaded once.
+// Malformed options, including min and max that are not in ISO 8601 duration format, are reported as IncorrectTagError
+// whether the variable is set or not, as Validate reports them.
+// Returns the errors of all fields joined, each is a *FieldError with the path of the field wrapping the parsing error
+// For example: Timeout isoduration.Duration `iso:"env=FLUSH_INTERVAL,default=PT30S,min=PT1S,max=P1D"`
+func LoadEnv(v any) error {
+	return LoadEnvWith(v, os.LookupEnv)
+}
+
+// LoadEnvWith populates v the way LoadEnv does, reading the variables with lookup instead of os.LookupEnv
+func LoadEnvWith(v any, lookup func(string) (string, bool)) error {
+	return walkFields(v, "iso", func(d *Duration, tag string) error {
+		options, err := parseTag(tag, "env", "default", "min", "max", "required")
+		if err != nil {
+			return err
+		}
+
+		if options["env"] == "" {
+			return NewIncorrectTagError(tag)
+		}
+
+		bounds, err := tagBounds(tag, options)
+		if err != nil {
+			return err
+		}
+
+		value, _ := lookup(options["env"])
+		_, isRequired := options["required"]
+		def, hasDefault := options["default"]
+
+		switch {
+		case value == "" && hasDefault:
+			value = def
+		case value == "" && isRequired:
+			return NewEnvNotSetError(options["env"])
+		case value == "":
+			return nil
+		}
+
+		parsed, err := ParseDuration(value)
+		if err != nil {
+			return err
+		}
+
+		if bounds != nil {
+			if err := bounds(parsed); err != nil {
+				return err
+			}
+		}
+
+		*d = *parsed
+		return nil
+	})
+}
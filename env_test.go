@@ -0,0 +1,143 @@
+package isoduration
+
+import (
+	"errors"
+	"testing"
+)
+
+type envConfig struct {
+	Flush   Duration  `iso:"env=FLUSH_INTERVAL,default=PT30S,min=PT1S,max=P1D"`
+	Timeout *Duration `iso:"env=TIMEOUT"`
+	Retry   *Duration `iso:"env=RETRY"`
+	Server  struct {
+		Idle Duration `iso:"env=SERVER_IDLE,required"`
+	}
+	Client *struct {
+		Keepalive Duration `iso:"env=CLIENT_KEEPALIVE,default=PT1M"`
+	}
+	Name string
+}
+
+type envBase struct {
+	Idle Duration `iso:"env=BASE_IDLE,default=PT5M"`
+}
+
+type envNode struct {
+	Wait Duration `iso:"env=NODE_WAIT"`
+	Next *envNode
+}
+
+type envEmbedded struct {
+	envBase
+	*envNode
+}
+
+func TestLoadEnv(t *testing.T) {
+	_, parseErr := ParseDuration("5s")
+
+	tests := []struct {
+		env     map[string]string
+		result  [4]string
+		isError bool
+		err     []error
+	}{
+		{
+			env:    map[string]string{"TIMEOUT": "PT5S", "SERVER_IDLE": "PT1M"},
+			result: [4]string{"PT30S", "PT5S", "PT1M", "PT1M"},
+		},
+		{
+			env:    map[string]string{"FLUSH_INTERVAL": "PT1H", "SERVER_IDLE": "P1D", "CLIENT_KEEPALIVE": "PT10S"},
+			result: [4]string{"PT1H", "", "P1D", "PT10S"},
+		},
+		{
+			env:     map[string]string{"FLUSH_INTERVAL": "P2D", "TIMEOUT": "5s"},
+			isError: true,
+			err: []error{
+				NewFieldError("Flush", NewOutOfRangeError("P2D", "P1D", true)),
+				NewFieldError("Timeout", parseErr),
+				NewFieldError("Server.Idle", NewEnvNotSetError("SERVER_IDLE")),
+			},
+		},
+	}
+
+	for i, v := range tests {
+		config := envConfig{}
+		config.Client = &struct {
+			Keepalive Duration `iso:"env=CLIENT_KEEPALIVE,default=PT1M"`
+		}{}
+
+		err := LoadEnvWith(&config, func(name string) (string, bool) {
+			value, ok := v.env[name]
+			return value, ok
+		})
+
+		timeout := ""
+		if config.Timeout != nil {
+			timeout = config.Timeout.String()
+		}
+
+		result := [4]string{config.Flush.String(), timeout, config.Server.Idle.String(), config.Client.Keepalive.String()}
+		matched := err != nil && v.isError && config.Retry == nil
+
+		for _, e := range v.err {
+			matched = matched && errors.Is(err, e)
+		}
+
+		switch {
+		case matched:
+			t.Logf("Test %d (input: %v) completed successfully", i, v.env)
+		case err == nil && !v.isError && result == v.result && config.Retry == nil:
+			t.Logf("Test %d (input: %v) completed successfully", i, v.env)
+		default:
+			t.Errorf("Test %d (input: %v) failed. Expected: %v. Result: %v, %v", i, v.env, v.result, result, err)
+		}
+	}
+}
+
+func TestLoadEnvTags(t *testing.T) {
+	tests := []struct {
+		input any
+		err   error
+	}{
+		{envConfig{}, InvalidTargetError},
+		{&struct {
+			D Duration `iso:"default=PT1S"`
+		}{}, NewFieldError("D", NewIncorrectTagError("default=PT1S"))},
+		{&struct {
+			D Duration `iso:"env=D,timeout=PT1S"`
+		}{}, NewFieldError("D", NewIncorrectTagError("env=D,timeout=PT1S"))},
+		{&struct {
+			D string `iso:"env=D"`
+		}{}, NewFieldError("D", NewIncorrectTagError("env=D"))},
+		{&struct {
+			D Duration `iso:"env=D,min=5s"`
+		}{}, NewFieldError("D", NewIncorrectTagError("env=D,min=5s"))},
+		{&struct {
+			D *Duration `iso:"env=D,default=PT1S,max=1m"`
+		}{}, NewFieldError("D", NewIncorrectTagError("env=D,default=PT1S,max=1m"))},
+	}
+
+	for i, v := range tests {
+		switch err := LoadEnvWith(v.input, func(string) (string, bool) { return "", false }); {
+		case errors.Is(err, v.err):
+			t.Logf("Test %d (input: %T) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %T) failed. Expected: %v. Result: %v", i, v.input, v.err, err)
+		}
+	}
+}
+
+func TestLoadEnvEmbedded(t *testing.T) {
+	node := &envNode{}
+	node.Next = &envNode{Next: node}
+	config := envEmbedded{envNode: node}
+
+	err := LoadEnvWith(&config, func(name string) (string, bool) {
+		return map[string]string{"NODE_WAIT": "PT1S"}[name], name == "NODE_WAIT"
+	})
+
+	result := [3]string{config.Idle.String(), node.Wait.String(), node.Next.Wait.String()}
+	if expected := [3]string{"PT5M", "PT1S", "PT1S"}; err != nil || result != expected {
+		t.Errorf("Test (input: embedded and cyclic structs) failed. Expected: %v. Result: %v, %v", expected, result, err)
+	}
+}
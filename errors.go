@@ -143,3 +143,200 @@ func (i *DesignatorMetError) Is(err error) bool {
 func NewDesignatorMetError(designator rune) *DesignatorMetError {
 	return &DesignatorMetError{"incorrect ISO 8601 duration format, the designator %c has already been processed", designator}
 }
+
+// IncorrectFormatError occurs when a string cannot be parsed in one of the supported non-ISO 8601 duration formats
+// For example: "1 yaer" in PostgreSQL interval format
+type IncorrectFormatError struct {
+	text   string
+	format string
+	in     string
+}
+
+// Error defines error output
+func (i *IncorrectFormatError) Error() string {
+	return fmt.Sprintf(i.text, i.format, i.in)
+}
+
+// Is checks for object matching
+func (i *IncorrectFormatError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewIncorrectFormatError creates new IncorrectFormatError
+func NewIncorrectFormatError(format, in string) *IncorrectFormatError {
+	return &IncorrectFormatError{"incorrect %s duration format, invalid tokens %s", format, in}
+}
+
+// MixedSignsError occurs when a duration has components with different signs, but the target format supports only a single sign
+// For example: P-1Y2M as XML Schema duration
+var MixedSignsError = errors.New("incorrect duration format, components with different signs cannot be represented")
+
+// UnsupportedDesignatorError occurs when the value of a designator cannot be represented in the target format
+// For example: P1Y in XML Schema dayTimeDuration or P1.5M in XML Schema duration
+type UnsupportedDesignatorError struct {
+	text       string
+	format     string
+	designator rune
+}
+
+// Error defines error output
+func (i *UnsupportedDesignatorError) Error() string {
+	return fmt.Sprintf(i.text, i.format, i.designator)
+}
+
+// Is checks for object matching
+func (i *UnsupportedDesignatorError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewUnsupportedDesignatorError creates new UnsupportedDesignatorError
+func NewUnsupportedDesignatorError(format string, designator rune) *UnsupportedDesignatorError {
+	return &UnsupportedDesignatorError{"incorrect %s duration format, the value of designator %c cannot be represented", format, designator}
+}
+
+// DurationOverflowError occurs when a duration is out of the range of the target type or format
+// For example: P300Y as time.Duration
+var DurationOverflowError = errors.New("incorrect duration, the value is out of range")
+
+// NegativeDurationError occurs when a duration is negative, but the target format or the check allows only non-negative durations
+// For example: -PT1S as systemd time span
+var NegativeDurationError = errors.New("incorrect duration, negative durations cannot be represented")
+
+// InvalidTargetError occurs when a loader or validator gets a value that is not a non-nil pointer to a struct
+// For example: LoadEnv(config) instead of LoadEnv(&config)
+var InvalidTargetError = errors.New("incorrect target, a non-nil pointer to a struct is expected")
+
+// IncorrectTagError occurs when a struct tag has unknown keys or values, or is set on a field that is not Duration or *Duration
+// For example: `iso:"env=TIMEOUT,default=30s"`
+type IncorrectTagError struct {
+	text string
+	tag  string
+}
+
+// Error defines error output
+func (i *IncorrectTagError) Error() string {
+	return fmt.Sprintf(i.text, i.tag)
+}
+
+// Is checks for object matching
+func (i *IncorrectTagError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewIncorrectTagError creates new IncorrectTagError
+func NewIncorrectTagError(tag string) *IncorrectTagError {
+	return &IncorrectTagError{"incorrect duration struct tag %q", tag}
+}
+
+// OutOfRangeError occurs when a duration is less than the minimum or greater than the maximum of a check
+// For example: PT0.5S with min=PT1S
+type OutOfRangeError struct {
+	text  string
+	value string
+	bound string
+}
+
+// Error defines error output
+func (i *OutOfRangeError) Error() string {
+	return fmt.Sprintf(i.text, i.value, i.bound)
+}
+
+// Is checks for object matching
+func (i *OutOfRangeError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewOutOfRangeError creates new OutOfRangeError, isMax defines whether bound is the maximum or the minimum
+func NewOutOfRangeError(value, bound string, isMax bool) *OutOfRangeError {
+	if isMax {
+		return &OutOfRangeError{"incorrect duration %s, the value is greater than the maximum %s", value, bound}
+	}
+
+	return &OutOfRangeError{"incorrect duration %s, the value is less than the minimum %s", value, bound}
+}
+
+// FieldError occurs when a struct field could not be loaded or validated, it wraps the cause and keeps the path of the field
+// For example: field Server.Timeout: incorrect ISO 8601 duration format
+type FieldError struct {
+	text string
+	path string
+	err  error
+}
+
+// Error defines error output
+func (i *FieldError) Error() string {
+	return fmt.Sprintf(i.text, i.path, i.err)
+}
+
+// Is checks for object matching
+func (i *FieldError) Is(err error) bool {
+	return is(i, err)
+}
+
+// Unwrap returns the cause of the error
+func (i *FieldError) Unwrap() error {
+	return i.err
+}
+
+// Path returns the path of the field, the names of the nested fields separated by dots
+func (i *FieldError) Path() string {
+	return i.path
+}
+
+// NewFieldError creates new FieldError
+func NewFieldError(path string, err error) *FieldError {
+	return &FieldError{"field %s: %v", path, err}
+}
+
+// EnvNotSetError occurs when a required environment variable is not set or empty
+// For example: `iso:"env=TIMEOUT,required"` without TIMEOUT
+type EnvNotSetError struct {
+	text string
+	name string
+}
+
+// Error defines error output
+func (i *EnvNotSetError) Error() string {
+	return fmt.Sprintf(i.text, i.name)
+}
+
+// Is checks for object matching
+func (i *EnvNotSetError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewEnvNotSetError creates new EnvNotSetError
+func NewEnvNotSetError(name string) *EnvNotSetError {
+	return &EnvNotSetError{"required environment variable %s is not set", name}
+}
+
+// NominalDurationError occurs when a duration has years or months, but the check allows only exact durations
+// For example: P1M with ExactOnly
+var NominalDurationError = errors.New("incorrect duration, years and months cannot be used as they have no fixed length")
+
+// RequiredDurationError occurs when a required duration is not set
+// For example: a nil *Duration field with the required tag option
+var RequiredDurationError = errors.New("incorrect duration, the value is required")
+
+// PrecisionError occurs when a duration is not a multiple of the precision of a check
+// For example: PT0.0001S with MaxPrecision(time.Millisecond)
+type PrecisionError struct {
+	text      string
+	value     string
+	precision string
+}
+
+// Error defines error output
+func (i *PrecisionError) Error() string {
+	return fmt.Sprintf(i.text, i.value, i.precision)
+}
+
+// Is checks for object matching
+func (i *PrecisionError) Is(err error) bool {
+	return is(i, err)
+}
+
+// NewPrecisionError creates new PrecisionError
+func NewPrecisionError(value, precision string) *PrecisionError {
+	return &PrecisionError{"incorrect duration %s, the value is more precise than %s", value, precision}
+}
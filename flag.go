@@ -0,0 +1,47 @@
+package isoduration
+
+import (
+	"flag"
+)
+
+// Set implements flag.Value, it parses a string in ISO 8601 duration format into *Duration
+func (d *Duration) Set(value string) error {
+	parsed, err := ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = *parsed
+	return nil
+}
+
+// Type returns the name of the flag value type shown by github.com/spf13/pflag in usage messages
+func (d *Duration) Type() string {
+	return "duration"
+}
+
+// DurationVar defines a flag in fs with the name, default value in ISO 8601 duration format and usage string,
+// the value of the flag is stored in p. flag.CommandLine is used if fs is nil.
+// Panics if the default value could not be parsed, as it is a programming error
+// For example: isoduration.DurationVar(nil, &timeout, "timeout", "PT30S", "request timeout")
+func DurationVar(fs *flag.FlagSet, p *Duration, name, value, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	if err := p.Set(value); err != nil {
+		panic("isoduration: invalid default value of flag " + name + ": " + err.Error())
+	}
+
+	fs.Var(p, name, usage)
+}
+
+// Flag defines a flag in fs the way DurationVar does and returns the address of its value.
+// flag.CommandLine is used if fs is nil
+// For example: timeout := isoduration.Flag(nil, "timeout", "PT30S", "request timeout")
+func Flag(fs *flag.FlagSet, name, value, usage string) *Duration {
+	p := new(Duration)
+	DurationVar(fs, p, name, value, usage)
+
+	return p
+}
@@ -0,0 +1,57 @@
+package isoduration
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+)
+
+func TestFlag(t *testing.T) {
+	tests := []struct {
+		args    []string
+		result  string
+		isError bool
+	}{
+		{args: nil, result: "PT30S"},
+		{args: []string{"-timeout", "PT1M"}, result: "PT1M"},
+		{args: []string{"--timeout=-P1DT2H"}, result: "-P1DT2H"},
+		{args: []string{"-timeout", "30s"}, isError: true},
+	}
+
+	for i, v := range tests {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(&bytes.Buffer{})
+		timeout := Flag(fs, "timeout", "PT30S", "request timeout")
+
+		switch err := fs.Parse(v.args); {
+		case err != nil && v.isError && strings.Contains(err.Error(), "invalid value"):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.args)
+		case err == nil && !v.isError && timeout.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.args)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s, %v", i, v.args, v.result, timeout, err)
+		}
+	}
+}
+
+func TestDurationVar(t *testing.T) {
+	var timeout Duration
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	out := &bytes.Buffer{}
+	fs.SetOutput(out)
+	DurationVar(fs, &timeout, "timeout", "PT5M", "request timeout")
+	fs.PrintDefaults()
+
+	if !strings.Contains(out.String(), "(default PT5M)") || timeout.Type() != "duration" {
+		t.Errorf("Test failed. Expected: default PT5M. Result: %s", out.String())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Test failed. Expected: panic on an invalid default")
+		}
+	}()
+	DurationVar(fs, &timeout, "other", "5m", "")
+}
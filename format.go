@@ -0,0 +1,48 @@
+package isoduration
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Format implements fmt.Formatter, width, precision and flags are honored as fmt applies them to the printed value:
+//
+//	%v, %s  the ISO 8601 form returned by String
+//	%q      the ISO 8601 form as a double-quoted string, or a backquoted one with the # flag
+//	%+v     every mark and the sign, for debugging
+//	%d      total whole seconds, truncated toward zero
+//	%f, %e, %g  total seconds with a fraction, %.3f prints milliseconds
+//
+// Years and months are counted as YearDays and MonthDays days in %d, %f, %e and %g the way ToTimeDuration counts them
+// For example: %q gives "PT1H30M", %d gives 5400, %10s gives   PT1H30M
+func (d *Duration) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		if f.Flag('+') {
+			fmt.Fprintf(f, fmt.FormatString(f, 's'), d.debug())
+			return
+		}
+		fmt.Fprintf(f, fmt.FormatString(f, 's'), d.String())
+	case 's', 'q':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), d.String())
+	case 'd':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), int64(math.Trunc(d.totalSeconds())))
+	case 'f', 'F', 'e', 'E', 'g', 'G':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), d.totalSeconds())
+	default:
+		fmt.Fprintf(f, "%%!%c(*isoduration.Duration=%s)", verb, d.String())
+	}
+}
+
+// debug represents every mark and the sign of *Duration the way %+v prints structs
+func (d *Duration) debug() string {
+	return "{negative:" + strconv.FormatBool(d.multiplier < 0) +
+		" years:" + strconv.FormatFloat(d.period.years, 'f', -1, 64) +
+		" months:" + strconv.FormatFloat(d.period.months, 'f', -1, 64) +
+		" weeks:" + strconv.FormatFloat(d.period.weeks, 'f', -1, 64) +
+		" days:" + strconv.FormatFloat(d.period.days, 'f', -1, 64) +
+		" hours:" + strconv.FormatFloat(d.time.hours, 'f', -1, 64) +
+		" minutes:" + strconv.FormatFloat(d.time.minutes, 'f', -1, 64) +
+		" seconds:" + strconv.FormatFloat(d.time.seconds, 'f', -1, 64) + "}"
+}
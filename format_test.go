@@ -0,0 +1,38 @@
+package isoduration
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		format string
+		input  *Duration
+		result string
+	}{
+		{"%v", NewDuration(0, 0, 0, 0, 1, 30, 0, false), "PT1H30M"},
+		{"%s", NewDuration(0, 0, 0, 0, 1, 30, 0, true), "-PT1H30M"},
+		{"%q", NewDuration(0, 0, 0, 0, 1, 30, 0, false), `"PT1H30M"`},
+		{"%#q", NewDuration(0, 0, 0, 0, 1, 30, 0, false), "`PT1H30M`"},
+		{"%10s|", NewDuration(0, 0, 0, 0, 1, 30, 0, false), "   PT1H30M|"},
+		{"%-10v|", NewDuration(0, 0, 0, 0, 1, 30, 0, false), "PT1H30M   |"},
+		{"%+v", NewDuration(1, 2, 3, 4, 5, 6, 7.5, true), "{negative:true years:1 months:2 weeks:4 days:3 hours:5 minutes:6 seconds:7.5}"},
+		{"%d", NewDuration(0, 0, 0, 0, 1, 30, 0.9, false), "5400"},
+		{"%d", NewDuration(0, 0, 0, 0, 0, 0, 1.9, true), "-1"},
+		{"%08d", NewDuration(0, 0, 1, 0, 0, 0, 0, false), "00086400"},
+		{"%.3f", NewDuration(0, 0, 0, 0, 0, 1, 0.0125, false), "60.013"},
+		{"%8.2f", NewDuration(0, 0, 0, 0, 0, 0, 1.5, true), "   -1.50"},
+		{"%g", NewDuration(0, 1, 0, 0, 0, 0, 0, false), "2.592e+06"},
+		{"%x", NewDuration(0, 0, 0, 0, 0, 0, 1, false), "%!x(*isoduration.Duration=PT1S)"},
+	}
+
+	for i, v := range tests {
+		switch r := fmt.Sprintf(v.format, v.input); r {
+		case v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.format)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.format, v.result, r)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package isoduration
+
+import (
+	"math"
+	"time"
+)
+
+// goFormat is the format name used in errors
+const goFormat = "Go"
+
+// ParseAny parses a string either in ISO 8601 duration format or in the time.ParseDuration syntax.
+// Strings in the Go syntax are converted into hours, minutes and seconds marks, so the result is exact.
+// Returns *Duration and an error if the string could not be parsed
+// For example: P1DT1H30M, -PT30S, 1h30m or 1.5µs
+func ParseAny(duration string) (*Duration, error) {
+	sign := 0
+
+	if duration != "" && (duration[0] == '-' || duration[0] == '+') {
+		sign = 1
+	}
+
+	if len(duration) > sign && duration[sign] == PERIOD {
+		return ParseDuration(duration)
+	}
+
+	t, err := time.ParseDuration(duration)
+	if err != nil {
+		return nil, NewIncorrectFormatError(goFormat, duration)
+	}
+
+	return newTimeDuration(t), nil
+}
+
+// FormatGo represents *Duration in the time.Duration string syntax, weeks and days are counted as 168 and 24 hours.
+// Returns an error if the duration has nominal marks or is out of time.Duration range
+// For example: 1h30m0s
+func (d *Duration) FormatGo() (string, error) {
+	switch {
+	case d.period.years != 0:
+		return "", NewUnsupportedDesignatorError(goFormat, YEAR)
+	case d.period.months != 0:
+		return "", NewUnsupportedDesignatorError(goFormat, MONTH)
+	case math.Abs(d.exactSeconds()) > math.MaxInt64/float64(time.Second):
+		return "", DurationOverflowError
+	}
+
+	return d.ToTimeDuration().String(), nil
+}
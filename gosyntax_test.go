@@ -0,0 +1,105 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseAny(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "P1DT1H30M",
+			result: NewDuration(0, 0, 1, 0, 1, 30, 0, false),
+		},
+		{
+			input:  "-PT30S",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 30, true),
+		},
+		{
+			input:  "1h30m",
+			result: NewDuration(0, 0, 0, 0, 1, 30, 0, false),
+		},
+		{
+			input:  "-90m250ms",
+			result: NewDuration(0, 0, 0, 0, 1, 30, 0.25, true),
+		},
+		{
+			input:  "1.5µs",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0.0000015, false),
+		},
+		{
+			input:   "P1H",
+			isError: true,
+			err:     NewIncorrectDesignatorError(PERIOD, HOUR),
+		},
+		{
+			input:   "1d",
+			isError: true,
+			err:     NewIncorrectFormatError(goFormat, "1d"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseAny(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatGo(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(0, 0, 0, 0, 1, 30, 0, false),
+			result: "1h30m0s",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 1, 0, 0, 0.25, true),
+			result: "-192h0m0.25s",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "0s",
+		},
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(goFormat, MONTH),
+		},
+		{
+			input:   NewDuration(0, 0, 0, 1e5, 0, 0, 0, false),
+			isError: true,
+			err:     DurationOverflowError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatGo()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
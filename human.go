@@ -0,0 +1,238 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// humanFormat is the format name used in errors
+const humanFormat = "human-readable"
+
+// Vocabulary defines the words recognized by ParseHumanWith in a language
+type Vocabulary struct {
+	// Units are unit words and abbreviations by unit index from years to seconds: years, months, weeks, days, hours, minutes
+	// and seconds
+	Units map[string]int
+	// Numbers are number words, including articles like a and an that count as one
+	Numbers map[string]float64
+	// Scales are words that multiply the number before them, scales of 1000 and more end a group of digits,
+	// for example hundred and thousand
+	Scales map[string]float64
+	// Fractions are words that take a part of the number before them or of one, for example half and quarter.
+	// A fraction after the last unit is added to that unit, as in an hour and a half
+	Fractions map[string]float64
+	// Conjunctions are words that separate units and parts of numbers, as in one and a half or 2 hours and 30 minutes
+	Conjunctions map[string]bool
+	// Fillers are words that are skipped, as of in three quarters of an hour
+	Fillers map[string]bool
+	// Decimal is the decimal separator used in numbers in addition to the dot, for example a comma
+	Decimal string
+}
+
+// EnglishVocabulary is the vocabulary used by ParseHuman
+var EnglishVocabulary = &Vocabulary{
+	Units: map[string]int{
+		"y": 0, "yr": 0, "yrs": 0, "year": 0, "years": 0,
+		"mo": 1, "mos": 1, "month": 1, "months": 1,
+		"w": 2, "wk": 2, "wks": 2, "week": 2, "weeks": 2,
+		"d": 3, "day": 3, "days": 3,
+		"h": 4, "hr": 4, "hrs": 4, "hour": 4, "hours": 4,
+		"m": 5, "min": 5, "mins": 5, "minute": 5, "minutes": 5,
+		"s": 6, "sec": 6, "secs": 6, "second": 6, "seconds": 6,
+	},
+	Numbers: map[string]float64{
+		"a": 1, "an": 1, "zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7,
+		"eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14, "fifteen": 15,
+		"sixteen": 16, "seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40,
+		"fifty": 50, "sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+	},
+	Scales:       map[string]float64{"hundred": 100, "thousand": 1000, "million": 1000000},
+	Fractions:    map[string]float64{"half": 0.5, "quarter": 0.25, "quarters": 0.25},
+	Conjunctions: map[string]bool{"and": true},
+	Fillers:      map[string]bool{"of": true},
+}
+
+// humanNumber accumulates a number written in words and digits
+type humanNumber struct {
+	sum      float64
+	total    float64
+	current  float64
+	fraction bool
+	empty    bool
+	// afterNumber is set if the last token was a number, last is its value or -1 if it was written in digits
+	afterNumber bool
+	last        float64
+}
+
+// value returns the accumulated number
+func (n *humanNumber) value() float64 {
+	return n.sum + n.total + n.current
+}
+
+// tokenizeHuman splits a string into lower-case words, numbers and commas, spaces and hyphens only separate tokens
+func tokenizeHuman(duration, decimal string) ([]string, bool) {
+	var tokens []string
+	runes := []rune(strings.ToLower(duration))
+
+	for i := 0; i < len(runes); {
+		r, start := runes[i], i
+
+		switch {
+		case unicode.IsDigit(r):
+			for i < len(runes) && (unicode.IsDigit(runes[i]) ||
+				((runes[i] == '.' || (decimal != "" && string(runes[i]) == decimal)) &&
+					i+1 < len(runes) && unicode.IsDigit(runes[i+1]))) {
+				i++
+			}
+
+			// a comma between digits is a digit group separator, as in 1,000, it is rejected as ambiguous
+			if i+1 < len(runes) && runes[i] == ',' && unicode.IsDigit(runes[i+1]) {
+				return nil, false
+			}
+		case unicode.IsLetter(r):
+			for i < len(runes) && unicode.IsLetter(runes[i]) {
+				i++
+			}
+		case r == ',':
+			i++
+		case unicode.IsSpace(r) || r == '-':
+			i++
+			continue
+		default:
+			return nil, false
+		}
+
+		tokens = append(tokens, string(runes[start:i]))
+	}
+
+	return tokens, true
+}
+
+// ParseHuman parses a duration written in English words, such as a user types it: unit words and abbreviations,
+// digits and number words, a and an, and and commas between units. Units may repeat and come in any order.
+// Numbers next to each other are rejected unless they form one number, as in twenty five, so are digit groups like 1,000.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 2 hours 30 minutes, 1.5 days, three weeks, an hour and a half or 2h30m
+func ParseHuman(duration string) (*Duration, error) {
+	return ParseHumanWith(duration, EnglishVocabulary)
+}
+
+// ParseHumanWith parses a duration written in words of the vocabulary the way ParseHuman does.
+// Returns *Duration and an error if the string could not be parsed
+func ParseHumanWith(duration string, vocabulary *Vocabulary) (*Duration, error) {
+	var marks [7]float64
+
+	tokens, ok := tokenizeHuman(duration, vocabulary.Decimal)
+	if !ok || len(tokens) == 0 {
+		return nil, NewIncorrectFormatError(humanFormat, duration)
+	}
+
+	number := humanNumber{empty: true}
+	last := -1
+
+	for _, token := range tokens {
+		if unit, ok := vocabulary.Units[token]; ok {
+			if number.empty {
+				return nil, NewIncorrectFormatError(humanFormat, duration)
+			}
+
+			marks[unit] += number.value()
+			number, last = humanNumber{empty: true}, unit
+			continue
+		}
+
+		if unicode.IsDigit([]rune(token)[0]) {
+			str := token
+			if vocabulary.Decimal != "" {
+				str = strings.Replace(token, vocabulary.Decimal, ".", 1)
+			}
+
+			v, err := strconv.ParseFloat(str, 64)
+			if err != nil {
+				return nil, NewIncorrectFormatError(humanFormat, duration)
+			}
+
+			if !number.add(v, -1) {
+				return nil, NewIncorrectFormatError(humanFormat, duration)
+			}
+			continue
+		}
+
+		if v, ok := vocabulary.Numbers[token]; ok {
+			if !number.add(v, v) {
+				return nil, NewIncorrectFormatError(humanFormat, duration)
+			}
+		} else if v, ok := vocabulary.Scales[token]; ok {
+			number.scale(v)
+		} else if v, ok := vocabulary.Fractions[token]; ok {
+			number.part(v)
+		} else if vocabulary.Fillers[token] {
+			continue
+		} else if token == "," || vocabulary.Conjunctions[token] {
+			// a conjunction inside a number starts a new part that is added to it, as in one and a half
+			number.sum, number.total, number.current, number.fraction, number.afterNumber = number.value(), 0, 0, false, false
+		} else {
+			return nil, NewIncorrectFormatError(humanFormat, duration)
+		}
+	}
+
+	// a number without a unit is allowed only as a part of the last unit, as in an hour and a half
+	if !number.empty {
+		v := number.value()
+		if last < 0 || v >= 1 {
+			return nil, NewIncorrectFormatError(humanFormat, duration)
+		}
+
+		marks[last] += v
+	}
+
+	return NewDuration(marks[0], marks[1], marks[3], marks[2], marks[4], marks[5], marks[6], false), nil
+}
+
+// add adds a number to the current group, or multiplies the group by it after a fraction, as in half an hour.
+// word is the value of a number word or -1 for digits. A number may follow another number only if it is
+// a number word from one to nine after tens, as in twenty five. Returns false if the number cannot follow
+// the last token, as in 2 3 or one two
+func (n *humanNumber) add(v, word float64) bool {
+	if n.afterNumber && !(n.last >= 20 && n.last < 100 && math.Mod(n.last, 10) == 0 && word >= 1 && word < 10) {
+		return false
+	}
+
+	if n.fraction {
+		n.current *= v
+	} else {
+		n.current += v
+	}
+
+	n.fraction, n.empty, n.afterNumber, n.last = false, false, true, word
+
+	return true
+}
+
+// scale multiplies the current group by a scale word, scales of 1000 and more end the group
+func (n *humanNumber) scale(v float64) {
+	if n.current == 0 {
+		n.current = 1
+	}
+
+	if v >= 1000 {
+		n.total += n.current * v
+		n.current = 0
+	} else {
+		n.current *= v
+	}
+
+	n.fraction, n.empty, n.afterNumber = false, false, false
+}
+
+// part takes a part of the current group or of one, as in a half, three quarters or half an hour
+func (n *humanNumber) part(v float64) {
+	if n.current == 0 {
+		n.current = 1
+	}
+
+	n.current *= v
+	n.fraction, n.empty, n.afterNumber = true, false, false
+}
@@ -0,0 +1,81 @@
+package isoduration
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseHuman(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  string
+		isError bool
+		err     error
+	}{
+		{input: "2 hours 30 minutes", result: "PT2H30M"},
+		{input: "1.5 days", result: "P1.5D"},
+		{input: "three weeks", result: "P3W"},
+		{input: "an hour and a half", result: "PT1.5H"},
+		{input: "half an hour", result: "PT0.5H"},
+		{input: "one and a half hours", result: "PT1.5H"},
+		{input: "2h30m", result: "PT2H30M"},
+		{input: "1 year, 2 months and 3 days", result: "P1Y2M3D"},
+		{input: "Twenty-five Minutes", result: "PT25M"},
+		{input: "a hundred and twenty seconds", result: "PT120S"},
+		{input: "two thousand five hundred secs", result: "PT2500S"},
+		{input: "three quarters of an hour", result: "PT0.75H"},
+		{input: "1 hr 1 hr", result: "PT2H"},
+		{input: "", isError: true, err: NewIncorrectFormatError(humanFormat, "")},
+		{input: "hours", isError: true, err: NewIncorrectFormatError(humanFormat, "hours")},
+		{input: "90", isError: true, err: NewIncorrectFormatError(humanFormat, "90")},
+		{input: "2 hours 30", isError: true, err: NewIncorrectFormatError(humanFormat, "2 hours 30")},
+		{input: "2 fortnights", isError: true, err: NewIncorrectFormatError(humanFormat, "2 fortnights")},
+		{input: "2 hours!", isError: true, err: NewIncorrectFormatError(humanFormat, "2 hours!")},
+		{input: "ninety nine minutes", result: "PT99M"},
+		{input: "one hundred five seconds", result: "PT105S"},
+		{input: "1,000 hours", isError: true, err: NewIncorrectFormatError(humanFormat, "1,000 hours")},
+		{input: "2 3 hours", isError: true, err: NewIncorrectFormatError(humanFormat, "2 3 hours")},
+		{input: "one two three minutes", isError: true, err: NewIncorrectFormatError(humanFormat, "one two three minutes")},
+		{input: "twenty five five minutes", isError: true, err: NewIncorrectFormatError(humanFormat, "twenty five five minutes")},
+		{input: "twenty 5 minutes", isError: true, err: NewIncorrectFormatError(humanFormat, "twenty 5 minutes")},
+		{input: "zero zero hours", isError: true, err: NewIncorrectFormatError(humanFormat, "zero zero hours")},
+	}
+
+	for i, v := range tests {
+		switch r, err := ParseHuman(v.input); {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && r.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %v, %v", i, v.input, v.result, r, err)
+		}
+	}
+}
+
+func TestParseHumanWith(t *testing.T) {
+	german := &Vocabulary{
+		Units:        map[string]int{"stunde": 4, "stunden": 4, "minute": 5, "minuten": 5},
+		Numbers:      map[string]float64{"eine": 1, "zwei": 2},
+		Fractions:    map[string]float64{"halbe": 0.5},
+		Conjunctions: map[string]bool{"und": true},
+		Decimal:      ",",
+	}
+
+	tests := []struct {
+		input  string
+		result string
+	}{
+		{"zwei Stunden und 1,5 Minuten", "PT2H1.5M"},
+		{"eine halbe Stunde", "PT0.5H"},
+	}
+
+	for i, v := range tests {
+		switch r, err := ParseHumanWith(v.input, german); {
+		case err == nil && r.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %v, %v", i, v.input, v.result, r, err)
+		}
+	}
+}
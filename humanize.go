@@ -0,0 +1,154 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// humanizeSeconds defines the lengths in seconds of the units used by Humanize from the largest to the smallest
+var humanizeSeconds = [7]float64{
+	YearDays * DayHours * 3600,
+	MonthDays * DayHours * 3600,
+	WeekDays * DayHours * 3600,
+	DayHours * 3600,
+	3600,
+	60,
+	1,
+}
+
+// humanizeConfig defines how Humanize renders *Duration
+type humanizeConfig struct {
+	locale      *Locale
+	short       bool
+	maxUnits    int
+	round       bool
+	separator   *string
+	conjunction string
+}
+
+// HumanizeOption configures Humanize
+type HumanizeOption func(*humanizeConfig)
+
+// WithShortUnits renders units in the short style, for example 2h 30m instead of 2 hours 30 minutes
+func WithShortUnits() HumanizeOption {
+	return func(c *humanizeConfig) { c.short = true }
+}
+
+// WithMaxUnits limits the number of rendered units to n largest non-zero units, n <= 0 means no limit
+func WithMaxUnits(n int) HumanizeOption {
+	return func(c *humanizeConfig) { c.maxUnits = n }
+}
+
+// WithRounding rounds the last rendered unit to a whole number, taking the dropped smaller units into account.
+// Without it the dropped units are truncated and the last unit is rendered as it is
+func WithRounding() HumanizeOption {
+	return func(c *humanizeConfig) { c.round = true }
+}
+
+// WithSeparator sets the string placed between units instead of the separator of the locale
+func WithSeparator(separator string) HumanizeOption {
+	return func(c *humanizeConfig) { c.separator = &separator }
+}
+
+// WithLocale renders units in the registered locale found by LookupLocale for a BCP 47 tag, English by default
+func WithLocale(tag string) HumanizeOption {
+	return func(c *humanizeConfig) { c.locale = LookupLocale(tag) }
+}
+
+// WithConjunction sets the word placed between the last two units instead of the separator,
+// for example WithSeparator(", ") and WithConjunction("and") render 1 year, 2 months and 3 hours
+func WithConjunction(conjunction string) HumanizeOption {
+	return func(c *humanizeConfig) { c.conjunction = conjunction }
+}
+
+// humanizeValues returns the values of the units used by Humanize, dropping and optionally rounding the units
+// beyond maxUnits. Returns the indexes of the units to render
+func (d *Duration) humanizeValues(c *humanizeConfig) ([7]float64, []int) {
+	values := [7]float64{
+		d.period.years, d.period.months, d.period.weeks, d.period.days,
+		d.time.hours, d.time.minutes, d.time.seconds,
+	}
+	shown := make([]int, 0, len(values))
+
+	for i, v := range values {
+		if v != 0 && (c.maxUnits <= 0 || len(shown) < c.maxUnits) {
+			shown = append(shown, i)
+		}
+	}
+
+	if len(shown) == 0 || !c.round {
+		return values, shown
+	}
+
+	last := shown[len(shown)-1]
+	for i := last + 1; i < len(values); i++ {
+		values[last] += values[i] * humanizeSeconds[i] / humanizeSeconds[last]
+	}
+	values[last] = math.Round(values[last])
+
+	// carry a rounded value like 60 seconds into the next larger unit, even if it is not rendered,
+	// so 1 hour 59.6 seconds becomes 1 hour 1 minute
+	for i := last; i > shown[0]; i-- {
+		ratio := humanizeSeconds[i-1] / humanizeSeconds[i]
+
+		if values[i] < ratio {
+			break
+		}
+
+		values[i-1]++
+		values[i] -= ratio
+	}
+
+	// the carry may leave zero units, which are not rendered, and fill a unit between the rendered ones
+	kept := shown[:0]
+	for i := shown[0]; i <= last; i++ {
+		if values[i] != 0 {
+			kept = append(kept, i)
+		}
+	}
+
+	return values, kept
+}
+
+// Humanize renders *Duration as words, by default in English in the long style with all non-zero units separated by spaces.
+// The form of each unit name follows the CLDR plural rule of the locale.
+// Units are rendered as they are stored, without normalization, negative durations are prefixed with a minus sign
+// For example: 1 year 2 months 3 hours, 2h 30m or 1.5 seconds
+func (d *Duration) Humanize(options ...HumanizeOption) string {
+	c := &humanizeConfig{locale: English}
+
+	for _, o := range options {
+		o(c)
+	}
+
+	separator := c.locale.Separator
+	if c.separator != nil {
+		separator = *c.separator
+	}
+
+	values, shown := d.humanizeValues(c)
+	parts := make([]string, 0, len(shown))
+	isZero := len(shown) == 0
+
+	if isZero {
+		shown = []int{len(values) - 1}
+	}
+
+	for _, i := range shown {
+		v := math.Round(values[i]*1e9) / 1e9
+		parts = append(parts, c.locale.name(i, strconv.FormatFloat(v, 'f', -1, 64), c.short))
+	}
+
+	str := strings.Join(parts, separator)
+
+	if c.conjunction != "" && len(parts) > 1 {
+		str = strings.Join(parts[:len(parts)-1], separator) + " " + c.conjunction + " " + parts[len(parts)-1]
+	}
+
+	if d.multiplier < 0 && !isZero {
+		return "-" + str
+	}
+
+	return str
+}
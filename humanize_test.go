@@ -0,0 +1,79 @@
+package isoduration
+
+import (
+	"testing"
+)
+
+func TestHumanize(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		options []HumanizeOption
+		result  string
+	}{
+		{
+			input:  NewDuration(0, 0, 0, 0, 2, 30, 0, false),
+			result: "2 hours 30 minutes",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 2, 30, 0, false),
+			options: []HumanizeOption{WithShortUnits()},
+			result:  "2h 30m",
+		},
+		{
+			input:   NewDuration(1, 2, 0, 0, 3, 0, 0, false),
+			options: []HumanizeOption{WithSeparator(", "), WithConjunction("and")},
+			result:  "1 year, 2 months and 3 hours",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 1, 0, 1, 1.5, true),
+			result: "-1 week 1 day 1 minute 1.5 seconds",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0.5, false),
+			result: "0.5 seconds",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 30, 45, false),
+			options: []HumanizeOption{WithMaxUnits(2)},
+			result:  "1 hour 30 minutes",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 30, 45, false),
+			options: []HumanizeOption{WithMaxUnits(2), WithRounding()},
+			result:  "1 hour 31 minutes",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 59, 45, false),
+			options: []HumanizeOption{WithMaxUnits(2), WithRounding()},
+			result:  "2 hours",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 0, 59.6, false),
+			options: []HumanizeOption{WithMaxUnits(2), WithRounding()},
+			result:  "1 hour 1 minute",
+		},
+		{
+			input:   NewDuration(0, 0, 1, 0, 0, 59, 59.6, false),
+			options: []HumanizeOption{WithMaxUnits(2), WithRounding()},
+			result:  "1 day 1 hour",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 0, 0.3, false),
+			options: []HumanizeOption{WithMaxUnits(1), WithRounding(), WithShortUnits()},
+			result:  "1h",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, true),
+			result: "0 seconds",
+		},
+	}
+
+	for i, v := range tests {
+		switch r := v.input.Humanize(v.options...); r {
+		case v.result:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
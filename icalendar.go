@@ -0,0 +1,174 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// icalendarFormat is the format name used in errors
+const icalendarFormat = "iCalendar"
+
+// iCalendar grammar
+var (
+	// icalendarFollow defines the designators allowed after each designator, RFC 5545 section 3.3.6
+	icalendarFollow = map[rune]string{
+		PERIOD: "WDT",
+		DAY:    "T",
+		TIME:   "HMS",
+		HOUR:   "M",
+		MINUTE: "S",
+	}
+	// icalendarIndexes defines the positions of the designator values in NewDuration arguments
+	icalendarIndexes = map[rune]int{WEEK: 3, DAY: 2, HOUR: 4, MINUTE: 5, SECOND: 6}
+)
+
+// ParseICalendar parses an RFC 5545 DURATION or TRIGGER value.
+// Unlike ParseDuration, years, months and fractions are not allowed, weeks cannot be combined with other designators
+// and time designators cannot skip each other, so PT1H0M5S is valid and PT1H5S is not.
+// Returns *Duration and an error if the string could not be parsed
+// For example: -PT15M, P15DT5H0M20S or P7W
+func ParseICalendar(duration string) (*Duration, error) {
+	var marks [7]float64
+	in := duration
+	isNegative := false
+
+	switch {
+	case strings.HasPrefix(duration, "-"):
+		isNegative = true
+		duration = duration[1:]
+	case strings.HasPrefix(duration, "+"):
+		duration = duration[1:]
+	}
+
+	if len(duration) < 3 || duration[0] != PERIOD || duration[len(duration)-1] == TIME {
+		return nil, NewIncorrectFormatError(icalendarFormat, in)
+	}
+
+	prev := rune(PERIOD)
+	start := 1
+
+	for i := 1; i < len(duration); i++ {
+		char := rune(duration[i])
+
+		if char >= '0' && char <= '9' {
+			continue
+		}
+
+		if !strings.ContainsRune(icalendarFollow[prev], char) || (char == TIME) != (start == i) {
+			return nil, NewIncorrectFormatError(icalendarFormat, in)
+		}
+
+		if char != TIME {
+			v, err := strconv.ParseUint(duration[start:i], 10, 63)
+			if err != nil {
+				return nil, NewIncorrectFormatError(icalendarFormat, in)
+			}
+			marks[icalendarIndexes[char]] = float64(v)
+		}
+
+		prev = char
+		start = i + 1
+	}
+
+	if start != len(duration) {
+		return nil, NewIncorrectFormatError(icalendarFormat, in)
+	}
+
+	return NewDuration(0, 0, marks[2], marks[3], marks[4], marks[5], marks[6], isNegative), nil
+}
+
+// FormatICalendar represents *Duration as an RFC 5545 DURATION value.
+// A duration of whole weeks only is kept in weeks, otherwise weeks are represented as days and the time is normalized
+// into hours, minutes and seconds. Returns an error if the duration has years, months or fractions,
+// use FormatICalendarApprox to convert such durations
+// For example: P15DT5H0M20S
+func (d *Duration) FormatICalendar() (string, error) {
+	switch {
+	case d.period.years != 0:
+		return "", NewUnsupportedDesignatorError(icalendarFormat, YEAR)
+	case d.period.months != 0:
+		return "", NewUnsupportedDesignatorError(icalendarFormat, MONTH)
+	}
+
+	days := d.period.weeks*WeekDays + d.period.days
+	seconds := (d.time.hours*60+d.time.minutes)*60 + d.time.seconds
+
+	switch {
+	case days != math.Trunc(days):
+		return "", NewUnsupportedDesignatorError(icalendarFormat, DAY)
+	case seconds != math.Trunc(seconds):
+		return "", NewUnsupportedDesignatorError(icalendarFormat, SECOND)
+	case (days < 0 && seconds > 0) || (days > 0 && seconds < 0):
+		return "", MixedSignsError
+	}
+
+	return d.formatICalendar(days, seconds), nil
+}
+
+// FormatICalendarApprox represents *Duration as an RFC 5545 DURATION value like FormatICalendar,
+// but instead of returning an error it approximates the values iCalendar cannot represent:
+// years and months are converted into days using YearDays and MonthDays, fractional days are carried into the time
+// and seconds are rounded
+// Affect: This may have some rounding inaccuracies
+func (d *Duration) FormatICalendarApprox() string {
+	days := d.period.years*YearDays + d.period.months*MonthDays + d.period.weeks*WeekDays + d.period.days
+	wholeDays := math.Trunc(days)
+	seconds := math.Round((days-wholeDays)*DayHours*3600 + (d.time.hours*60+d.time.minutes)*60 + d.time.seconds)
+
+	if (wholeDays < 0 && seconds > 0) || (wholeDays > 0 && seconds < 0) {
+		// mixed signs are resolved by carrying the days into the time
+		return d.formatICalendar(0, wholeDays*DayHours*3600+seconds)
+	}
+
+	return d.formatICalendar(wholeDays, seconds)
+}
+
+// formatICalendar formats whole days and whole seconds of the same sign as an RFC 5545 DURATION value
+func (d *Duration) formatICalendar(days, seconds float64) string {
+	if days == 0 && seconds == 0 {
+		return "PT0S"
+	}
+
+	b := strings.Builder{}
+
+	if (days < 0 || seconds < 0) != (d.multiplier < 0) {
+		b.WriteByte('-')
+	}
+
+	b.WriteRune(PERIOD)
+
+	days, seconds = math.Abs(days), math.Abs(seconds)
+	onlyWeeks := d.period.years == 0 && d.period.months == 0 && d.period.days == 0
+
+	if seconds == 0 && onlyWeeks && d.period.weeks == math.Trunc(d.period.weeks) {
+		return b.String() + strconv.FormatFloat(days/WeekDays, 'f', -1, 64) + string(WEEK)
+	}
+
+	if days != 0 {
+		b.WriteString(strconv.FormatFloat(days, 'f', -1, 64))
+		b.WriteRune(DAY)
+	}
+
+	if seconds != 0 {
+		ss := int64(seconds)
+		hour, min, sec := ss/3600, ss%3600/60, ss%60
+
+		b.WriteRune(TIME)
+
+		if hour != 0 {
+			b.WriteString(strconv.FormatInt(hour, 10))
+			b.WriteRune(HOUR)
+		}
+		if min != 0 || (hour != 0 && sec != 0) {
+			b.WriteString(strconv.FormatInt(min, 10))
+			b.WriteRune(MINUTE)
+		}
+		if sec != 0 {
+			b.WriteString(strconv.FormatInt(sec, 10))
+			b.WriteRune(SECOND)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseICalendar(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "-PT15M",
+			result: NewDuration(0, 0, 0, 0, 0, 15, 0, true),
+		},
+		{
+			input:  "P15DT5H0M20S",
+			result: NewDuration(0, 0, 15, 0, 5, 0, 20, false),
+		},
+		{
+			input:  "+P7W",
+			result: NewDuration(0, 0, 0, 7, 0, 0, 0, false),
+		},
+		{
+			input:   "P1M",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "P1M"),
+		},
+		{
+			input:   "P1W1D",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "P1W1D"),
+		},
+		{
+			input:   "PT1H5S",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "PT1H5S"),
+		},
+		{
+			input:   "PT1.5S",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "PT1.5S"),
+		},
+		{
+			input:   "P1DT",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "P1DT"),
+		},
+		{
+			input:   "P1D1T",
+			isError: true,
+			err:     NewIncorrectFormatError(icalendarFormat, "P1D1T"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseICalendar(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatICalendar(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		approx  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(0, 0, 15, 0, 5, 0, 20, false),
+			result: "P15DT5H0M20S",
+			approx: "P15DT5H0M20S",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 90, 0, true),
+			result: "-PT1H30M",
+			approx: "-PT1H30M",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 2, 0, 0, 0, false),
+			result: "P2W",
+			approx: "P2W",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 1, 0, 0, 0, false),
+			result: "P8D",
+			approx: "P8D",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, true),
+			result: "PT0S",
+			approx: "PT0S",
+		},
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			approx:  "P30D",
+			isError: true,
+			err:     NewUnsupportedDesignatorError(icalendarFormat, MONTH),
+		},
+		{
+			input:   NewDuration(0, 0, 1.5, 0, 0, 0, 0, true),
+			approx:  "-P1DT12H",
+			isError: true,
+			err:     NewUnsupportedDesignatorError(icalendarFormat, DAY),
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 0, 1.6, false),
+			approx:  "PT2S",
+			isError: true,
+			err:     NewUnsupportedDesignatorError(icalendarFormat, SECOND),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatICalendar()
+		approx := v.input.FormatICalendarApprox()
+
+		switch {
+		case approx != v.approx:
+			t.Errorf("Test %d (input: %s) failed. Expected approximation: %s. Result: %s", i, v.input, v.approx, approx)
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
@@ -99,6 +99,14 @@ func TestString(t *testing.T) {
 			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, true),
 			result: "PT0S",
 		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 15, 0, true),
+			result: "-PT15M",
+		},
+		{
+			input:  NewDuration(1, 0, 0, 0, 0, 0, 0, true),
+			result: "-P1Y",
+		},
 	}
 	for i, v := range tests {
 		switch {
@@ -111,6 +119,32 @@ func TestString(t *testing.T) {
 
 }
 
+func TestAppendFormat(t *testing.T) {
+	tests := []struct {
+		prefix string
+		input  *Duration
+		result string
+	}{
+		{prefix: "timeout=", input: NewDuration(1, 2, 4, 3, 5, 6, 7.5, false), result: "timeout=P1Y2M3W4DT5H6M7.5S"},
+		{prefix: "", input: NewDuration(0, 0, 0, 0, 1, 30, 0, true), result: "-PT1H30M"},
+		{prefix: "", input: NewDuration(0, 0, 1.5, 0, 0, 0, 0, false), result: "P1.5D"},
+		{prefix: "[", input: NewDuration(0, 0, 0, 0, 0, 0, 0, true), result: "[PT0S"},
+		{prefix: "", input: &Duration{}, result: "PT0S"},
+		{prefix: "", input: NewDuration(0, 0, 0, 0, 0, 0, 1e21, false), result: "PT1000000000000000000000S"},
+	}
+
+	for i, v := range tests {
+		r := string(v.input.AppendFormat([]byte(v.prefix)))
+
+		switch {
+		case r == v.result && v.input.String() == v.result[len(v.prefix):]:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.result)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.result, v.result, r)
+		}
+	}
+}
+
 func TestNewFromTimeDuration(t *testing.T) {
 	tests := []struct {
 		input          time.Duration
@@ -213,7 +247,6 @@ func TestParseLetter(t *testing.T) {
 		state, designator rune
 		nums              string
 		tm                *TimeDuration
-		desDef            map[rune]timeDesignatorFunc
 		result            *TimeDuration
 		isError           bool
 		err               error
@@ -223,7 +256,6 @@ func TestParseLetter(t *testing.T) {
 			designator: SECOND,
 			nums:       "0",
 			tm:         &TimeDuration{},
-			desDef:     timeDesignatorsDef,
 			result:     &TimeDuration{seconds: 0},
 			isError:    false,
 			err:        nil,
@@ -233,7 +265,6 @@ func TestParseLetter(t *testing.T) {
 			designator: HOUR,
 			nums:       "10",
 			tm:         &TimeDuration{},
-			desDef:     timeDesignatorsDef,
 			result:     &TimeDuration{hours: float64(10)},
 			isError:    false,
 			err:        nil,
@@ -243,7 +274,6 @@ func TestParseLetter(t *testing.T) {
 			designator: DAY,
 			nums:       "10",
 			tm:         &TimeDuration{},
-			desDef:     timeDesignatorsDef,
 			result:     &TimeDuration{hours: float64(10)},
 			isError:    true,
 			err:        NewIncorrectDesignatorError(TIME, DAY),
@@ -253,7 +283,6 @@ func TestParseLetter(t *testing.T) {
 			designator: HOUR,
 			nums:       "10, 5",
 			tm:         &TimeDuration{},
-			desDef:     timeDesignatorsDef,
 			result:     &TimeDuration{hours: float64(10)},
 			isError:    true,
 			err:        NewIncorrectIsoFormatError("10, 5"),
@@ -263,7 +292,6 @@ func TestParseLetter(t *testing.T) {
 			designator: HOUR,
 			nums:       "10",
 			tm:         &TimeDuration{hours: 1},
-			desDef:     timeDesignatorsDef,
 			result:     &TimeDuration{hours: float64(1)},
 			isError:    true,
 			err:        NewDesignatorMetError(HOUR),
@@ -274,7 +302,6 @@ func TestParseLetter(t *testing.T) {
 		state, designator rune
 		nums              string
 		tm                *PeriodDuration
-		desDef            map[rune]periodDesignatorFunc
 		result            *PeriodDuration
 		isError           bool
 		err               error
@@ -284,7 +311,6 @@ func TestParseLetter(t *testing.T) {
 			designator: YEAR,
 			nums:       "10.5",
 			tm:         &PeriodDuration{},
-			desDef:     periodDesignatorsDef,
 			result:     &PeriodDuration{years: 10.5},
 			isError:    false,
 			err:        nil,
@@ -294,7 +320,6 @@ func TestParseLetter(t *testing.T) {
 			designator: HOUR,
 			nums:       "10",
 			tm:         &PeriodDuration{},
-			desDef:     periodDesignatorsDef,
 			result:     &PeriodDuration{years: float64(10)},
 			isError:    true,
 			err:        NewIncorrectDesignatorError(PERIOD, HOUR),
@@ -304,7 +329,6 @@ func TestParseLetter(t *testing.T) {
 			designator: YEAR,
 			nums:       "10, 5",
 			tm:         &PeriodDuration{},
-			desDef:     periodDesignatorsDef,
 			result:     nil,
 			isError:    true,
 			err:        NewIncorrectIsoFormatError("10, 5"),
@@ -314,7 +338,6 @@ func TestParseLetter(t *testing.T) {
 			designator: YEAR,
 			nums:       "10",
 			tm:         &PeriodDuration{years: 10},
-			desDef:     periodDesignatorsDef,
 			result:     nil,
 			isError:    true,
 			err:        NewDesignatorMetError(YEAR),
@@ -322,12 +345,13 @@ func TestParseLetter(t *testing.T) {
 	}
 	t.Run("testsParseLetterForTimeDuration", func(t *testing.T) {
 		for i, v := range testsTimeDuration {
-			err := parseLetter(v.state, v.designator, v.nums, v.tm, v.desDef)
+			d := &Duration{time: *v.tm}
+			err := parseLetter(d, v.state, v.designator, v.nums)
 
 			switch {
 			case err != nil && v.isError && errors.Is(err, v.err):
 				t.Logf("Test %d (state: %c, designator: %c, nums: %s) completed successfully", i, v.state, v.designator, v.nums)
-			case err == nil && v.result.hours == v.tm.hours:
+			case err == nil && v.result.hours == d.time.hours:
 				t.Logf("Test %d (state: %c, designator: %c, nums: %s) completed successfully", i, v.state, v.designator, v.nums)
 			default:
 				t.Errorf("Test %d (state: %c, designator: %c, nums: %s) failed.", i, v.state, v.designator, v.nums)
@@ -337,12 +361,13 @@ func TestParseLetter(t *testing.T) {
 
 	t.Run("testsParseLetterForPeriodDuration", func(t *testing.T) {
 		for i, v := range testsPeriodDuration {
-			err := parseLetter(v.state, v.designator, v.nums, v.tm, v.desDef)
+			d := &Duration{period: *v.tm}
+			err := parseLetter(d, v.state, v.designator, v.nums)
 
 			switch {
 			case err != nil && v.isError && errors.Is(err, v.err):
 				t.Logf("Test %d (state: %c, designator: %c, nums: %s) completed successfully", i, v.state, v.designator, v.nums)
-			case err == nil && v.result.years == v.tm.years:
+			case err == nil && v.result.years == d.period.years:
 				t.Logf("Test %d (state: %c, designator: %c, nums: %s) completed successfully", i, v.state, v.designator, v.nums)
 			default:
 				t.Errorf("Test %d (state: %c, designator: %c, nums: %s) failed.", i, v.state, v.designator, v.nums)
@@ -452,3 +477,115 @@ func TestParseDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInto(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{input: "P1Y2M3W4DT5H6M7.5S", result: NewDuration(1, 2, 4, 3, 5, 6, 7.5, false)},
+		{input: "-PT1H30M", result: NewDuration(0, 0, 0, 0, 1, 30, 0, true)},
+		{input: "+P1D", result: NewDuration(0, 0, 1, 0, 0, 0, 0, false)},
+		{input: "", isError: true, err: IsNotIsoFormatError},
+		{input: "P1Y1Y", isError: true, err: NewDesignatorMetError(YEAR)},
+		{input: "PT1,5H", isError: true, err: NewIncorrectIsoFormatError("1,5")},
+		{input: "P1DT", isError: true, err: TimeIsEmptyError},
+		{input: "PT5", isError: true, err: NewDesignatorNotFoundError(TIME, "5")},
+	}
+
+	for i, v := range tests {
+		// dst keeps its value if the input could not be parsed
+		dst := *NewDuration(0, 0, 0, 0, 0, 0, 1, false)
+		err := ParseInto(&dst, v.input)
+
+		b, bytesErr := ParseBytes([]byte(v.input))
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err) && errors.Is(bytesErr, v.err) &&
+			reflect.DeepEqual(dst, *NewDuration(0, 0, 0, 0, 0, 0, 1, false)) && reflect.DeepEqual(b, Duration{}):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && bytesErr == nil && !v.isError && reflect.DeepEqual(dst, *v.result) && reflect.DeepEqual(b, *v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s, %s, %v, %v", i, v.input, v.result, &dst, &b, err, bytesErr)
+		}
+	}
+}
+
+func TestAddTo(t *testing.T) {
+	tests := []struct {
+		input  *Duration
+		from   time.Time
+		result time.Time
+	}{
+		{
+			input:  NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			from:   time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC),
+			result: time.Date(2024, time.February, 29, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			input:  NewDuration(1, 1, 1, 1, 1, 1, 1, false),
+			from:   time.Date(2023, time.December, 31, 23, 0, 0, 0, time.UTC),
+			result: time.Date(2025, time.February, 9, 0, 1, 1, 0, time.UTC),
+		},
+		{
+			input:  NewDuration(0, 1, 1, 0, 0, 0, 0, true),
+			from:   time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+			result: time.Date(2024, time.February, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			input:  NewDuration(0, 0, 0.5, 0, 0, 0, 0, false),
+			from:   time.Date(2024, time.March, 31, 0, 0, 0, 0, time.UTC),
+			result: time.Date(2024, time.March, 31, 12, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for i, v := range tests {
+		switch r := v.input.AddTo(v.from); {
+		case r.Equal(v.result):
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		from   time.Time
+		to     time.Time
+		result string
+	}{
+		{
+			from:   time.Date(2024, time.January, 31, 0, 0, 0, 0, time.UTC),
+			to:     time.Date(2024, time.March, 1, 0, 0, 0, 0, time.UTC),
+			result: "P1M1D",
+		},
+		{
+			from:   time.Date(2023, time.December, 31, 23, 0, 0, 0, time.UTC),
+			to:     time.Date(2025, time.February, 9, 0, 1, 1, 0, time.UTC),
+			result: "P1Y1M8DT1H1M1S",
+		},
+		{
+			from:   time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+			to:     time.Date(2024, time.February, 1, 13, 30, 0, 0, time.UTC),
+			result: "-P28DT22H30M",
+		},
+		{
+			from:   time.Date(2024, time.March, 30, 12, 0, 0, 0, time.FixedZone("", 3600)),
+			to:     time.Date(2024, time.March, 30, 12, 0, 0, 0, time.FixedZone("", 3600)),
+			result: "PT0S",
+		},
+	}
+
+	for i, v := range tests {
+		switch r := Between(v.from, v.to); {
+		case r.String() == v.result && (v.to.Before(v.from) || r.AddTo(v.from).Equal(v.to)):
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, r)
+		default:
+			t.Errorf("Test %d (input: %s - %s) failed. Expected: %s. Result: %s", i, v.from, v.to, v.result, r)
+		}
+	}
+}
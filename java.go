@@ -0,0 +1,223 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// java.time format names used in errors
+const (
+	javaDurationFormat = "java.time.Duration"
+	javaPeriodFormat   = "java.time.Period"
+)
+
+// javaIndexes defines the positions of the designator values in NewDuration arguments for period and time designators
+var javaIndexes = [2]map[rune]int{
+	{YEAR: yearsMark, MONTH: monthsMark, WEEK: weeksMark, DAY: daysMark},
+	{HOUR: hoursMark, MINUTE: minutesMark, SECOND: secondsMark},
+}
+
+// parseJava parses the ISO 8601 duration syntax the way java.time does: case-insensitive, with an optional sign
+// for the whole duration and for each component, and with a fraction of up to 9 digits only in seconds.
+// period and time are the designators allowed before and after T in their required order
+func parseJava(duration, format, period, time string) (*Duration, error) {
+	var marks [7]float64
+	str, isNegative := cutSign(strings.ToUpper(duration))
+
+	if !strings.HasPrefix(str, string(PERIOD)) {
+		return nil, NewIncorrectFormatError(format, duration)
+	}
+
+	date, clock, hasTime := strings.Cut(str[1:], string(TIME))
+	if (hasTime && (time == "" || clock == "")) || (!hasTime && date == "") {
+		return nil, NewIncorrectFormatError(format, duration)
+	}
+
+	parts := [2]struct {
+		value       string
+		designators string
+	}{{date, period}, {clock, time}}
+
+	for i, part := range parts {
+		value, next := part.value, 0
+
+		for value != "" {
+			end := strings.IndexFunc(value, isLetter)
+			if end < 0 {
+				return nil, NewIncorrectFormatError(format, duration)
+			}
+
+			designator := rune(value[end])
+			pos := strings.IndexRune(part.designators[next:], designator)
+			if pos < 0 {
+				return nil, NewIncorrectFormatError(format, duration)
+			}
+
+			v, err := parseJavaNumber(value[:end], designator == SECOND && i == 1)
+			if err != nil {
+				return nil, NewIncorrectFormatError(format, duration)
+			}
+
+			marks[javaIndexes[i][designator]] = v
+			next += pos + 1
+			value = value[end+1:]
+		}
+	}
+
+	if isNegative {
+		for i := range marks {
+			marks[i] = 0 - marks[i]
+		}
+	}
+
+	return newSignedDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6]), nil
+}
+
+// parseJavaNumber parses a signed integer, or a signed decimal with a dot or comma and up to 9 fraction digits if fraction is set
+func parseJavaNumber(number string, fraction bool) (float64, error) {
+	whole, frac, hasFrac := strings.Cut(strings.Replace(number, ",", ".", 1), ".")
+	digits := strings.TrimLeft(whole, "+-")
+
+	if len(whole)-len(digits) > 1 || digits == "" || strings.ContainsAny(digits, "+-") ||
+		(hasFrac && (!fraction || len(frac) > 9 || strings.ContainsAny(frac, "+-"))) {
+		return 0, strconv.ErrSyntax
+	}
+
+	if frac == "" {
+		return strconv.ParseFloat(whole, 64)
+	}
+
+	return strconv.ParseFloat(whole+"."+frac, 64)
+}
+
+// ParseJavaDuration parses a string the way java.time.Duration.parse does, including days and signs per component.
+// Returns *Duration and an error if the string could not be parsed
+// For example: PT8H6M12.345S, PT-0.5S, -PT6H3M or P2DT3H4M
+func ParseJavaDuration(duration string) (*Duration, error) {
+	return parseJava(duration, javaDurationFormat, "D", "HMS")
+}
+
+// FormatJavaDuration represents *Duration exactly as java.time.Duration.toString does: days are converted into hours,
+// and negative durations have a sign per component.
+// Returns an error if the duration has nominal marks or is out of range
+// For example: PT8H6M12.345S or PT-0.5S
+func (d *Duration) FormatJavaDuration() (string, error) {
+	switch {
+	case d.period.years != 0:
+		return "", NewUnsupportedDesignatorError(javaDurationFormat, YEAR)
+	case d.period.months != 0:
+		return "", NewUnsupportedDesignatorError(javaDurationFormat, MONTH)
+	case math.Abs(d.exactSeconds()) >= math.MaxInt64:
+		return "", DurationOverflowError
+	}
+
+	seconds, nanos := splitSeconds(d.exactSeconds())
+	if seconds == 0 && nanos == 0 {
+		return "PT0S", nil
+	}
+
+	effective := seconds
+	if seconds < 0 && nanos > 0 {
+		effective++
+	}
+
+	hours, minutes, secs := effective/3600, effective%3600/60, effective%60
+	b := strings.Builder{}
+
+	b.WriteString("PT")
+
+	if hours != 0 {
+		b.WriteString(strconv.FormatInt(hours, 10))
+		b.WriteRune(HOUR)
+	}
+	if minutes != 0 {
+		b.WriteString(strconv.FormatInt(minutes, 10))
+		b.WriteRune(MINUTE)
+	}
+	if secs == 0 && nanos == 0 && b.Len() > 2 {
+		return b.String(), nil
+	}
+
+	if seconds < 0 && nanos > 0 && secs == 0 {
+		b.WriteString("-0")
+	} else {
+		b.WriteString(strconv.FormatInt(secs, 10))
+	}
+
+	if nanos > 0 {
+		frac := nanos + 1e9
+		if seconds < 0 {
+			frac = 2e9 - nanos
+		}
+		b.WriteString("." + strings.TrimRight(strconv.FormatInt(frac, 10), "0")[1:])
+	}
+
+	b.WriteRune(SECOND)
+
+	return b.String(), nil
+}
+
+// ParseJavaPeriod parses a string the way java.time.Period.parse does: integer years, months, weeks and days
+// with signs per component. Weeks are kept in their own mark.
+// Returns *Duration and an error if the string could not be parsed
+// For example: P1Y2M3D, P-1Y2M or -P2W
+func ParseJavaPeriod(period string) (*Duration, error) {
+	d, err := parseJava(period, javaPeriodFormat, "YMWD", "")
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range [4]float64{d.period.years, d.period.months, d.period.weeks, d.period.days} {
+		if math.Abs(v) > math.MaxInt32 {
+			return nil, DurationOverflowError
+		}
+	}
+
+	return d, nil
+}
+
+// FormatJavaPeriod represents *Duration exactly as java.time.Period.toString does: weeks are converted into days,
+// years and months are not normalized and each component keeps its own sign.
+// Returns an error if the duration has time marks, fractions or is out of range
+// For example: P1Y2M3D or P-1Y-2M
+func (d *Duration) FormatJavaPeriod() (string, error) {
+	marks := [3]struct {
+		value      float64
+		designator rune
+	}{
+		{d.period.years * d.multiplier, YEAR},
+		{d.period.months * d.multiplier, MONTH},
+		{(d.period.weeks*WeekDays + d.period.days) * d.multiplier, DAY},
+	}
+
+	switch {
+	case d.time.hours != 0:
+		return "", NewUnsupportedDesignatorError(javaPeriodFormat, HOUR)
+	case d.time.minutes != 0:
+		return "", NewUnsupportedDesignatorError(javaPeriodFormat, MINUTE)
+	case d.time.seconds != 0:
+		return "", NewUnsupportedDesignatorError(javaPeriodFormat, SECOND)
+	}
+
+	b := strings.Builder{}
+	b.WriteRune(PERIOD)
+
+	for _, v := range marks {
+		switch {
+		case v.value != math.Trunc(v.value):
+			return "", NewUnsupportedDesignatorError(javaPeriodFormat, v.designator)
+		case math.Abs(v.value) > math.MaxInt32:
+			return "", DurationOverflowError
+		case v.value != 0:
+			b.WriteString(strconv.FormatInt(int64(v.value), 10))
+			b.WriteRune(v.designator)
+		}
+	}
+
+	if b.Len() == 1 {
+		return "P0D", nil
+	}
+
+	return b.String(), nil
+}
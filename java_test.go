@@ -0,0 +1,222 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseJavaDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "PT8H6M12.345S",
+			result: NewDuration(0, 0, 0, 0, 8, 6, 12.345, false),
+		},
+		{
+			input:  "PT-0.5S",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0.5, true),
+		},
+		{
+			input:  "-PT6H3M",
+			result: NewDuration(0, 0, 0, 0, 6, 3, 0, true),
+		},
+		{
+			input:  "pt-6h+3m",
+			result: NewDuration(0, 0, 0, 0, -6, 3, 0, false),
+		},
+		{
+			input:  "P2DT3H4M1,5S",
+			result: NewDuration(0, 0, 2, 0, 3, 4, 1.5, false),
+		},
+		{
+			input:   "P1Y",
+			isError: true,
+			err:     NewIncorrectFormatError(javaDurationFormat, "P1Y"),
+		},
+		{
+			input:   "PT",
+			isError: true,
+			err:     NewIncorrectFormatError(javaDurationFormat, "PT"),
+		},
+		{
+			input:   "PT1.5M",
+			isError: true,
+			err:     NewIncorrectFormatError(javaDurationFormat, "PT1.5M"),
+		},
+		{
+			input:   "PT1S1M",
+			isError: true,
+			err:     NewIncorrectFormatError(javaDurationFormat, "PT1S1M"),
+		},
+		{
+			input:   "PT0.1234567891S",
+			isError: true,
+			err:     NewIncorrectFormatError(javaDurationFormat, "PT0.1234567891S"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseJavaDuration(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatJavaDuration(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(0, 0, 0, 0, 8, 6, 12.345, false),
+			result: "PT8H6M12.345S",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 8, 6, 12.345, true),
+			result: "PT-8H-6M-12.345S",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0.5, true),
+			result: "PT-0.5S",
+		},
+		{
+			input:  NewDuration(0, 0, 2, 0, 0, 0, 0, false),
+			result: "PT48H",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "PT0S",
+		},
+		{
+			input:   NewDuration(1, 0, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(javaDurationFormat, YEAR),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatJavaDuration()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestParseJavaPeriod(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "P1Y2M3D",
+			result: NewDuration(1, 2, 3, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "P-1Y2M",
+			result: NewDuration(-1, 2, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "-P2W",
+			result: NewDuration(0, 0, 0, 2, 0, 0, 0, true),
+		},
+		{
+			input:   "P1.5Y",
+			isError: true,
+			err:     NewIncorrectFormatError(javaPeriodFormat, "P1.5Y"),
+		},
+		{
+			input:   "P1DT1H",
+			isError: true,
+			err:     NewIncorrectFormatError(javaPeriodFormat, "P1DT1H"),
+		},
+		{
+			input:   "P3000000000D",
+			isError: true,
+			err:     DurationOverflowError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseJavaPeriod(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatJavaPeriod(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(1, 14, 3, 0, 0, 0, 0, false),
+			result: "P1Y14M3D",
+		},
+		{
+			input:  NewDuration(1, 2, 0, 0, 0, 0, 0, true),
+			result: "P-1Y-2M",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 2, 0, 0, 0, false),
+			result: "P15D",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "P0D",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(javaPeriodFormat, HOUR),
+		},
+		{
+			input:   NewDuration(0, 1.5, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(javaPeriodFormat, MONTH),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatJavaPeriod()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
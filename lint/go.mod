@@ -0,0 +1,8 @@
+module github.com/MyBlackJay/isoduration/lint
+
+go 1.21
+
+require (
+	github.com/MyBlackJay/isoduration v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
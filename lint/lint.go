@@ -0,0 +1,310 @@
+// Package lint finds durations in JSON and YAML configuration files and checks them in ISO 8601 duration format,
+// so bad values are reported with their positions before a service starts
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/MyBlackJay/isoduration"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultKeys are the key patterns used if Options has neither Paths nor Keys
+var DefaultKeys = []string{"*_duration", "*_timeout", "*_interval"}
+
+// NotStringError occurs when a value at a checked key is not a string
+// For example: timeout: 30 or timeout: {seconds: 30}
+var NotStringError = errors.New("incorrect duration, a string in ISO 8601 duration format is expected")
+
+// UnsupportedFileError occurs when the extension of a file is neither .json nor .yaml or .yml
+var UnsupportedFileError = errors.New("unsupported file, .json, .yaml or .yml is expected")
+
+// Options defines which values are checked and how
+type Options struct {
+	// Paths are key paths of the values to check, segments are separated by dots and may be patterns of path.Match,
+	// sequence items are matched by their index. For example: server.timeout or jobs.*.interval
+	Paths []string
+	// Keys are patterns of path.Match for the last key of the values to check. For example: *_timeout
+	Keys []string
+	// Min and Max are the bounds of the values, nil bounds are not checked
+	Min, Max *isoduration.Duration
+}
+
+// Diagnostic is a problem found in a file
+type Diagnostic struct {
+	File   string
+	Line   int
+	Column int
+	Path   string
+	Value  string
+	Err    error
+	// Fix is the ISO 8601 replacement of a value in the time.ParseDuration syntax, nil for other problems
+	Fix *Fix
+}
+
+// Fix replaces the bytes of a value between Offset and End in the file with Text, quotes are kept
+type Fix struct {
+	Offset int
+	End    int
+	Text   string
+}
+
+// String represents Diagnostic in the file:line:column: path: message form used by compilers and linters
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d:%d: %s: %v", d.File, d.Line, d.Column, d.Path, d.Err)
+}
+
+// MarshalJSON represents Diagnostic as a JSON object with the error as a message
+func (d Diagnostic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		File    string `json:"file"`
+		Line    int    `json:"line"`
+		Column  int    `json:"column"`
+		Path    string `json:"path"`
+		Value   string `json:"value"`
+		Message string `json:"message"`
+	}{d.File, d.Line, d.Column, d.Path, d.Value, d.Err.Error()})
+}
+
+// matches checks whether the value at the path is checked, segments must not be empty
+func (o *Options) matches(segments []string) bool {
+	paths, keys := o.Paths, o.Keys
+	if len(paths) == 0 && len(keys) == 0 {
+		keys = DefaultKeys
+	}
+
+	for _, p := range paths {
+		patterns := strings.Split(p, ".")
+		matched := len(patterns) == len(segments)
+
+		for i := 0; matched && i < len(patterns); i++ {
+			matched, _ = path.Match(patterns[i], segments[i])
+		}
+
+		if matched {
+			return true
+		}
+	}
+
+	for _, k := range keys {
+		if matched, _ := path.Match(k, segments[len(segments)-1]); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// check parses and checks a value, returns nil if it is correct
+func (o *Options) check(value string) error {
+	d, err := isoduration.ParseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	return d.Validate(isoduration.Range(o.Min, o.Max))
+}
+
+// source is a parsed file with the offsets of its lines
+type source struct {
+	file  string
+	data  []byte
+	lines []int
+}
+
+// newSource finds the offsets of the lines of data
+func newSource(file string, data []byte) *source {
+	lines := []int{0}
+
+	for i, b := range data {
+		if b == '\n' {
+			lines = append(lines, i+1)
+		}
+	}
+
+	return &source{file, data, lines}
+}
+
+// offset converts a line and a column counted in characters, both starting at 1, into a byte offset in data
+func (s *source) offset(line, column int) int {
+	if line < 1 || line > len(s.lines) {
+		return -1
+	}
+
+	offset := s.lines[line-1]
+	for ; column > 1 && offset < len(s.data); column-- {
+		_, size := utf8.DecodeRune(s.data[offset:])
+		offset += size
+	}
+
+	return offset
+}
+
+// fix returns the ISO 8601 replacement of a string value in the time.ParseDuration syntax,
+// nil if the value is in another syntax or is not written in the file as it was parsed
+func (s *source) fix(node *yaml.Node) *Fix {
+	t, err := time.ParseDuration(node.Value)
+	if err != nil {
+		return nil
+	}
+
+	offset := s.offset(node.Line, node.Column)
+	if offset < 0 {
+		return nil
+	}
+
+	switch node.Style {
+	case yaml.DoubleQuotedStyle, yaml.SingleQuotedStyle:
+		offset++
+	case 0:
+	default:
+		return nil
+	}
+
+	end := offset + len(node.Value)
+	if end > len(s.data) || string(s.data[offset:end]) != node.Value {
+		return nil
+	}
+
+	return &Fix{offset, end, isoduration.FormatTimeDuration(t)}
+}
+
+// walk checks the values of the node and its children, segments is the path of the node
+func (o *Options) walk(src *source, node *yaml.Node, segments []string, diagnostics []Diagnostic) []Diagnostic {
+	switch node.Kind {
+	case yaml.DocumentNode:
+		for _, n := range node.Content {
+			diagnostics = o.walk(src, n, segments, diagnostics)
+		}
+		return diagnostics
+	case yaml.SequenceNode:
+		for i, n := range node.Content {
+			diagnostics = o.walk(src, n, append(segments[:len(segments):len(segments)], strconv.Itoa(i)), diagnostics)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			diagnostics = o.walk(src, node.Content[i+1], append(segments[:len(segments):len(segments)], node.Content[i].Value), diagnostics)
+		}
+	}
+
+	if len(segments) == 0 || !o.matches(segments) || node.Tag == "!!null" || node.Kind == yaml.AliasNode {
+		return diagnostics
+	}
+
+	var err error
+	var fix *Fix
+
+	if node.Kind != yaml.ScalarNode || node.Tag != "!!str" {
+		err = NotStringError
+	} else if err = o.check(node.Value); err != nil {
+		fix = src.fix(node)
+	}
+
+	if err != nil {
+		diagnostics = append(diagnostics, Diagnostic{src.file, node.Line, node.Column, strings.Join(segments, "."), node.Value, err, fix})
+	}
+
+	return diagnostics
+}
+
+// YAML checks the durations in YAML data, file is the name used in diagnostics.
+// Returns the diagnostics sorted by position and an error if the data could not be parsed
+func YAML(file string, data []byte, options Options) ([]Diagnostic, error) {
+	var diagnostics []Diagnostic
+	src := newSource(file, data)
+	decoder := yaml.NewDecoder(strings.NewReader(string(data)))
+
+	for {
+		var node yaml.Node
+
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		diagnostics = options.walk(src, &node, nil, diagnostics)
+	}
+
+	sort.SliceStable(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		return a.Line < b.Line || (a.Line == b.Line && a.Column < b.Column)
+	})
+
+	return diagnostics, nil
+}
+
+// JSON checks the durations in JSON data, file is the name used in diagnostics.
+// Returns the diagnostics sorted by position and an error if the data is not valid JSON
+func JSON(file string, data []byte, options Options) ([]Diagnostic, error) {
+	var v any
+
+	// JSON is parsed as YAML to get the positions of values, so its syntax is checked by encoding/json first
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("%s: %w", file, err)
+	}
+
+	return YAML(file, data, options)
+}
+
+// Apply replaces the values of the diagnostics that have fixes in data, the rest of data including comments
+// and formatting is kept. The diagnostics must be found in data. Returns the new data
+func Apply(data []byte, diagnostics []Diagnostic) []byte {
+	var fixes []*Fix
+	for _, d := range diagnostics {
+		if d.Fix != nil {
+			fixes = append(fixes, d.Fix)
+		}
+	}
+
+	sort.Slice(fixes, func(i, j int) bool { return fixes[i].Offset < fixes[j].Offset })
+
+	result := make([]byte, 0, len(data))
+	last := 0
+
+	for _, f := range fixes {
+		if f.Offset < last {
+			continue
+		}
+
+		result = append(append(result, data[last:f.Offset]...), f.Text...)
+		last = f.End
+	}
+
+	return append(result, data[last:]...)
+}
+
+// Check checks the durations in JSON or YAML data chosen by the extension of name, name is used in diagnostics.
+// Returns the diagnostics sorted by position and an error if the data could not be parsed
+func Check(name string, data []byte, options Options) ([]Diagnostic, error) {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".json":
+		return JSON(name, data, options)
+	case ".yaml", ".yml":
+		return YAML(name, data, options)
+	}
+
+	return nil, fmt.Errorf("%s: %w", name, UnsupportedFileError)
+}
+
+// File checks the durations in a JSON or YAML file chosen by its extension.
+// Returns the diagnostics sorted by position and an error if the file could not be read or parsed
+func File(name string, options Options) ([]Diagnostic, error) {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return Check(name, data, options)
+}
@@ -0,0 +1,162 @@
+package lint
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/MyBlackJay/isoduration"
+)
+
+const yamlConfig = `server:
+  read_timeout: PT30S
+  write_timeout: 30s
+  idle: PT5M
+jobs:
+  - name: cleanup
+    interval: P1D
+  - name: report
+    interval: PT0.5S
+    retry_duration: 10
+cache_ttl: ~
+`
+
+func TestYAML(t *testing.T) {
+	_, parseErr := isoduration.ParseDuration("30s")
+
+	tests := []struct {
+		options Options
+		result  []Diagnostic
+	}{
+		{
+			options: Options{},
+			result: []Diagnostic{
+				{"config.yaml", 3, 18, "server.write_timeout", "30s", parseErr, &Fix{47, 50, "PT30S"}},
+				{"config.yaml", 10, 21, "jobs.1.retry_duration", "10", NotStringError, nil},
+			},
+		},
+		{
+			options: Options{Paths: []string{"jobs.*.interval", "server.idle", "cache_ttl"}, Min: isoduration.NewDuration(0, 0, 0, 0, 0, 0, 1, false)},
+			result: []Diagnostic{
+				{"config.yaml", 9, 15, "jobs.1.interval", "PT0.5S", isoduration.NewOutOfRangeError("PT0.5S", "PT1S", false), nil},
+			},
+		},
+		{
+			options: Options{Keys: []string{"idle"}, Max: isoduration.NewDuration(0, 0, 0, 0, 0, 1, 0, false)},
+			result: []Diagnostic{
+				{"config.yaml", 4, 9, "server.idle", "PT5M", isoduration.NewOutOfRangeError("PT5M", "PT1M", true), nil},
+			},
+		},
+	}
+
+	for i, v := range tests {
+		r, err := YAML("config.yaml", []byte(yamlConfig), v.options)
+		matched := err == nil && len(r) == len(v.result)
+
+		for j := 0; matched && j < len(r); j++ {
+			a, b := r[j], v.result[j]
+			matched = a.File == b.File && a.Line == b.Line && a.Column == b.Column && a.Path == b.Path &&
+				a.Value == b.Value && errors.Is(a.Err, b.Err) && (a.Fix == nil) == (b.Fix == nil) && (a.Fix == nil || *a.Fix == *b.Fix)
+		}
+
+		switch {
+		case matched:
+			t.Logf("Test %d (input: %+v) completed successfully", i, v.options)
+		default:
+			t.Errorf("Test %d (input: %+v) failed. Expected: %v. Result: %v, %v", i, v.options, v.result, r, err)
+		}
+	}
+}
+
+func TestFile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"ok.json":     `{"request_timeout": "PT1S", "retries": 3}`,
+		"bad.json":    "{\n  \"request_timeout\": \"1s\"\n}",
+		"broken.json": `{"request_timeout": }`,
+		"config.toml": `request_timeout = "PT1S"`,
+	}
+
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		result  string
+		isError bool
+	}{
+		{name: "ok.json"},
+		{name: "bad.json", result: filepath.Join(dir, "bad.json") + ":2:22: request_timeout: "},
+		{name: "broken.json", isError: true},
+		{name: "config.toml", isError: true},
+		{name: "missing.yaml", isError: true},
+	}
+
+	for i, v := range tests {
+		r, err := File(filepath.Join(dir, v.name), Options{})
+
+		switch {
+		case err != nil && v.isError:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.name)
+		case err == nil && !v.isError && v.result == "" && len(r) == 0:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.name)
+		case err == nil && !v.isError && len(r) == 1 && r[0].String()[:len(v.result)] == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.name)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %v, %v", i, v.name, v.result, r, err)
+		}
+	}
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		file   string
+		input  string
+		result string
+	}{
+		{
+			file:   "config.yaml",
+			input:  "# timeouts\nread_timeout: 90s # seconds\nwrite_timeout: \"1h30m\"\nidle_timeout: 'PT1M'\nname: ąę\njob_interval: '720h'\n",
+			result: "# timeouts\nread_timeout: PT1M30S # seconds\nwrite_timeout: \"PT1H30M\"\nidle_timeout: 'PT1M'\nname: ąę\njob_interval: 'P1M'\n",
+		},
+		{
+			file:   "config.yaml",
+			input:  "jobs:\n  - {name: ó, retry_interval: 1.5s}\n  - retry_interval: -2m\n",
+			result: "jobs:\n  - {name: ó, retry_interval: PT1.5S}\n  - retry_interval: -PT2M\n",
+		},
+		{
+			file:   "config.yaml",
+			input:  "request_timeout: >-\n  30s\nbad_timeout: 30x\n",
+			result: "request_timeout: >-\n  30s\nbad_timeout: 30x\n",
+		},
+		{
+			file:   "config.json",
+			input:  "{\n  \"read_timeout\": \"250ms\",\n  \"write_timeout\": \"PT1S\"\n}\n",
+			result: "{\n  \"read_timeout\": \"PT0.25S\",\n  \"write_timeout\": \"PT1S\"\n}\n",
+		},
+	}
+
+	for i, v := range tests {
+		var r []Diagnostic
+		var err error
+
+		if filepath.Ext(v.file) == ".json" {
+			r, err = JSON(v.file, []byte(v.input), Options{})
+		} else {
+			r, err = YAML(v.file, []byte(v.input), Options{})
+		}
+
+		result := string(Apply([]byte(v.input), r))
+
+		switch {
+		case err == nil && result == v.result:
+			t.Logf("Test %d (input: %q) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %q) failed. Expected: %q. Result: %q, %v", i, v.input, v.result, result, err)
+		}
+	}
+}
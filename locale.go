@@ -0,0 +1,277 @@
+package isoduration
+
+import (
+	"strings"
+	"sync"
+)
+
+// UnitNames defines the forms of a unit name by CLDR plural category, PluralOther is used for missing categories
+type UnitNames map[PluralCategory]string
+
+// Locale defines how Humanize renders *Duration in a language
+type Locale struct {
+	// Tag is the BCP 47 language tag of the locale, for example ru or pt-BR
+	Tag string
+	// Plural selects the form of a unit name for a number, PluralOtherOnly is used if it is nil
+	Plural PluralRule
+	// Units are the long unit names from years to seconds: years, months, weeks, days, hours, minutes and seconds
+	Units [7]UnitNames
+	// Short are the short unit names from years to seconds, they are appended to the number as they are
+	Short [7]string
+	// Space is placed between the number and the long unit name
+	Space string
+	// Separator is placed between units unless WithSeparator is used
+	Separator string
+	// Decimal is the decimal separator, a dot if it is empty
+	Decimal string
+
+	// RelativeUnits are the long unit names used in relative phrases if the language inflects them there,
+	// Units are used for missing units
+	RelativeUnits [7]UnitNames
+	// Future, Past and About are the patterns of relative phrases, %s is replaced by the rendered units
+	Future, Past, About string
+	// JustNow, Yesterday and Tomorrow are the words of relative phrases for short and one day durations
+	JustNow, Yesterday, Tomorrow string
+}
+
+// name returns the name of the unit with the index i for the number rendered as number
+func (l *Locale) name(i int, number string, short bool) string {
+	if short {
+		return number + l.Short[i]
+	}
+
+	return l.longName(l.Units[i], number)
+}
+
+// longName returns the form of the long unit name from names for the number rendered as number
+func (l *Locale) longName(names UnitNames, number string) string {
+	whole, frac, _ := strings.Cut(strings.TrimPrefix(number, "-"), ".")
+	rule := l.Plural
+
+	if rule == nil {
+		rule = PluralOtherOnly
+	}
+
+	var integer int64
+	for _, c := range whole {
+		integer = integer*10 + int64(c-'0')
+	}
+
+	name, ok := names[rule(integer, len(frac))]
+	if !ok {
+		name = names[PluralOther]
+	}
+
+	if l.Decimal != "" {
+		number = strings.Replace(number, ".", l.Decimal, 1)
+	}
+
+	return number + l.Space + name
+}
+
+// English is the default locale of Humanize
+var English = &Locale{
+	Tag:    "en",
+	Plural: PluralOneOther,
+	Units: [7]UnitNames{
+		{PluralOne: "year", PluralOther: "years"},
+		{PluralOne: "month", PluralOther: "months"},
+		{PluralOne: "week", PluralOther: "weeks"},
+		{PluralOne: "day", PluralOther: "days"},
+		{PluralOne: "hour", PluralOther: "hours"},
+		{PluralOne: "minute", PluralOther: "minutes"},
+		{PluralOne: "second", PluralOther: "seconds"},
+	},
+	Short:     [7]string{"y", "mo", "w", "d", "h", "m", "s"},
+	Space:     " ",
+	Separator: " ",
+	Future:    "in %s",
+	Past:      "%s ago",
+	About:     "about %s",
+	JustNow:   "just now",
+	Yesterday: "yesterday",
+	Tomorrow:  "tomorrow",
+}
+
+// Russian is the Russian locale of Humanize
+var Russian = &Locale{
+	Tag:    "ru",
+	Plural: PluralRussian,
+	Units: [7]UnitNames{
+		{PluralOne: "год", PluralFew: "года", PluralMany: "лет", PluralOther: "года"},
+		{PluralOne: "месяц", PluralFew: "месяца", PluralMany: "месяцев", PluralOther: "месяца"},
+		{PluralOne: "неделя", PluralFew: "недели", PluralMany: "недель", PluralOther: "недели"},
+		{PluralOne: "день", PluralFew: "дня", PluralMany: "дней", PluralOther: "дня"},
+		{PluralOne: "час", PluralFew: "часа", PluralMany: "часов", PluralOther: "часа"},
+		{PluralOne: "минута", PluralFew: "минуты", PluralMany: "минут", PluralOther: "минуты"},
+		{PluralOne: "секунда", PluralFew: "секунды", PluralMany: "секунд", PluralOther: "секунды"},
+	},
+	Short:     [7]string{" г.", " мес.", " нед.", " дн.", " ч", " мин", " с"},
+	Space:     " ",
+	Separator: " ",
+	Decimal:   ",",
+	RelativeUnits: [7]UnitNames{
+		2: {PluralOne: "неделю", PluralFew: "недели", PluralMany: "недель", PluralOther: "недели"},
+		5: {PluralOne: "минуту", PluralFew: "минуты", PluralMany: "минут", PluralOther: "минуты"},
+		6: {PluralOne: "секунду", PluralFew: "секунды", PluralMany: "секунд", PluralOther: "секунды"},
+	},
+	Future:    "через %s",
+	Past:      "%s назад",
+	About:     "примерно %s",
+	JustNow:   "только что",
+	Yesterday: "вчера",
+	Tomorrow:  "завтра",
+}
+
+// Polish is the Polish locale of Humanize
+var Polish = &Locale{
+	Tag:    "pl",
+	Plural: PluralPolish,
+	Units: [7]UnitNames{
+		{PluralOne: "rok", PluralFew: "lata", PluralMany: "lat", PluralOther: "roku"},
+		{PluralOne: "miesiąc", PluralFew: "miesiące", PluralMany: "miesięcy", PluralOther: "miesiąca"},
+		{PluralOne: "tydzień", PluralFew: "tygodnie", PluralMany: "tygodni", PluralOther: "tygodnia"},
+		{PluralOne: "dzień", PluralFew: "dni", PluralMany: "dni", PluralOther: "dnia"},
+		{PluralOne: "godzina", PluralFew: "godziny", PluralMany: "godzin", PluralOther: "godziny"},
+		{PluralOne: "minuta", PluralFew: "minuty", PluralMany: "minut", PluralOther: "minuty"},
+		{PluralOne: "sekunda", PluralFew: "sekundy", PluralMany: "sekund", PluralOther: "sekundy"},
+	},
+	Short:     [7]string{" r.", " mies.", " tydz.", " d.", " godz.", " min", " s"},
+	Space:     " ",
+	Separator: " ",
+	Decimal:   ",",
+	RelativeUnits: [7]UnitNames{
+		4: {PluralOne: "godzinę", PluralFew: "godziny", PluralMany: "godzin", PluralOther: "godziny"},
+		5: {PluralOne: "minutę", PluralFew: "minuty", PluralMany: "minut", PluralOther: "minuty"},
+		6: {PluralOne: "sekundę", PluralFew: "sekundy", PluralMany: "sekund", PluralOther: "sekundy"},
+	},
+	Future:    "za %s",
+	Past:      "%s temu",
+	About:     "mniej więcej %s",
+	JustNow:   "przed chwilą",
+	Yesterday: "wczoraj",
+	Tomorrow:  "jutro",
+}
+
+// German is the German locale of Humanize
+var German = &Locale{
+	Tag:    "de",
+	Plural: PluralOneOther,
+	Units: [7]UnitNames{
+		{PluralOne: "Jahr", PluralOther: "Jahre"},
+		{PluralOne: "Monat", PluralOther: "Monate"},
+		{PluralOne: "Woche", PluralOther: "Wochen"},
+		{PluralOne: "Tag", PluralOther: "Tage"},
+		{PluralOne: "Stunde", PluralOther: "Stunden"},
+		{PluralOne: "Minute", PluralOther: "Minuten"},
+		{PluralOne: "Sekunde", PluralOther: "Sekunden"},
+	},
+	Short:     [7]string{" J.", " Mon.", " Wo.", " T.", " Std.", " Min.", " Sek."},
+	Space:     " ",
+	Separator: " ",
+	Decimal:   ",",
+	RelativeUnits: [7]UnitNames{
+		{PluralOne: "Jahr", PluralOther: "Jahren"},
+		{PluralOne: "Monat", PluralOther: "Monaten"},
+		3: {PluralOne: "Tag", PluralOther: "Tagen"},
+	},
+	Future:    "in %s",
+	Past:      "vor %s",
+	About:     "etwa %s",
+	JustNow:   "gerade eben",
+	Yesterday: "gestern",
+	Tomorrow:  "morgen",
+}
+
+// French is the French locale of Humanize
+var French = &Locale{
+	Tag:    "fr",
+	Plural: PluralFrench,
+	Units: [7]UnitNames{
+		{PluralOne: "an", PluralOther: "ans"},
+		{PluralOne: "mois", PluralOther: "mois"},
+		{PluralOne: "semaine", PluralOther: "semaines"},
+		{PluralOne: "jour", PluralOther: "jours"},
+		{PluralOne: "heure", PluralOther: "heures"},
+		{PluralOne: "minute", PluralOther: "minutes"},
+		{PluralOne: "seconde", PluralOther: "secondes"},
+	},
+	Short:     [7]string{" a", " mois", " sem.", " j", " h", " min", " s"},
+	Space:     " ",
+	Separator: " ",
+	Decimal:   ",",
+	Future:    "dans %s",
+	Past:      "il y a %s",
+	About:     "environ %s",
+	JustNow:   "à l’instant",
+	Yesterday: "hier",
+	Tomorrow:  "demain",
+}
+
+// Japanese is the Japanese locale of Humanize
+var Japanese = &Locale{
+	Tag:    "ja",
+	Plural: PluralOtherOnly,
+	Units: [7]UnitNames{
+		{PluralOther: "年"},
+		{PluralOther: "か月"},
+		{PluralOther: "週間"},
+		{PluralOther: "日"},
+		{PluralOther: "時間"},
+		{PluralOther: "分"},
+		{PluralOther: "秒"},
+	},
+	Short:     [7]string{"年", "か月", "週", "日", "時間", "分", "秒"},
+	Future:    "%s後",
+	Past:      "%s前",
+	About:     "約%s",
+	JustNow:   "たった今",
+	Yesterday: "昨日",
+	Tomorrow:  "明日",
+}
+
+// locales holds the registered locales by lower-case tag
+var locales = struct {
+	sync.RWMutex
+	tags map[string]*Locale
+}{tags: map[string]*Locale{}}
+
+func init() {
+	for _, l := range []*Locale{English, Russian, Polish, German, French, Japanese} {
+		RegisterLocale(l)
+	}
+}
+
+// RegisterLocale makes the locale available to LookupLocale and WithLocale by its tag,
+// a locale registered with the same tag is replaced
+func RegisterLocale(l *Locale) {
+	locales.Lock()
+	defer locales.Unlock()
+
+	locales.tags[strings.ToLower(strings.ReplaceAll(l.Tag, "_", "-"))] = l
+}
+
+// LookupLocale returns the registered locale for a BCP 47 tag. Subtags are removed from the end
+// until a registered locale is found, English is returned if there is none
+// For example: ru-RU finds ru, zh-Hant-TW tries zh-Hant-TW, zh-Hant and zh
+func LookupLocale(tag string) *Locale {
+	locales.RLock()
+	defer locales.RUnlock()
+
+	tag = strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+
+	for tag != "" {
+		if l, ok := locales.tags[tag]; ok {
+			return l
+		}
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			break
+		}
+
+		tag = tag[:i]
+	}
+
+	return English
+}
@@ -0,0 +1,88 @@
+package isoduration
+
+import (
+	"testing"
+)
+
+func TestHumanizeLocale(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		options []HumanizeOption
+		result  string
+	}{
+		{
+			input:   NewDuration(1, 0, 0, 0, 0, 0, 0, false),
+			options: []HumanizeOption{WithLocale("ru")},
+			result:  "1 год",
+		},
+		{
+			input:   NewDuration(2, 0, 0, 0, 0, 0, 0, false),
+			options: []HumanizeOption{WithLocale("ru-RU")},
+			result:  "2 года",
+		},
+		{
+			input:   NewDuration(5, 0, 21, 0, 0, 11, 1.5, false),
+			options: []HumanizeOption{WithLocale("ru")},
+			result:  "5 лет 21 день 11 минут 1,5 секунды",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 2, 30, 0, false),
+			options: []HumanizeOption{WithLocale("ru"), WithShortUnits()},
+			result:  "2 ч 30 мин",
+		},
+		{
+			input:   NewDuration(1, 22, 5, 0, 0, 0, 0, false),
+			options: []HumanizeOption{WithLocale("pl_PL")},
+			result:  "1 rok 22 miesiące 5 dni",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 2, 0, false),
+			options: []HumanizeOption{WithLocale("de"), WithSeparator(", "), WithConjunction("und")},
+			result:  "1 Stunde und 2 Minuten",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			options: []HumanizeOption{WithLocale("fr-CA")},
+			result:  "0 seconde",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1.5, 0, 0, false),
+			options: []HumanizeOption{WithLocale("fr")},
+			result:  "1,5 heure",
+		},
+		{
+			input:   NewDuration(1, 2, 0, 0, 3, 0, 0, false),
+			options: []HumanizeOption{WithLocale("ja-JP")},
+			result:  "1年2か月3時間",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 0, 0, false),
+			options: []HumanizeOption{WithLocale("xx-YY")},
+			result:  "1 hour",
+		},
+	}
+
+	for i, v := range tests {
+		switch r := v.input.Humanize(v.options...); r {
+		case v.result:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
+
+func TestRegisterLocale(t *testing.T) {
+	RegisterLocale(&Locale{
+		Tag:       "eo",
+		Plural:    PluralOneOther,
+		Units:     [7]UnitNames{6: {PluralOne: "sekundo", PluralOther: "sekundoj"}},
+		Space:     " ",
+		Separator: " ",
+	})
+
+	d := NewDuration(0, 0, 0, 0, 0, 0, 2, false)
+	if r := d.Humanize(WithLocale("eo")); r != "2 sekundoj" {
+		t.Errorf("Test (input: %s) failed. Expected: 2 sekundoj. Result: %s", d, r)
+	}
+}
@@ -0,0 +1,48 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// abs returns the absolute value of v
+func abs(v int64) int64 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// formatFraction formats the non-negative fraction frac/unit, where unit is a power of ten, as a decimal point
+// followed by digits without trailing zeros. Returns an empty string if frac is zero
+// For example: formatFraction(500, 1000) returns .5
+func formatFraction(frac, unit int64) string {
+	if frac == 0 {
+		return ""
+	}
+
+	return "." + strings.TrimRight(strconv.FormatInt(frac+unit, 10)[1:], "0")
+}
+
+// splitSeconds splits seconds into whole seconds rounded down and nanoseconds in the range [0, 1e9)
+func splitSeconds(seconds float64) (int64, int64) {
+	whole := math.Floor(seconds)
+	nanos := int64(math.Round((seconds - whole) * 1e9))
+
+	if nanos == 1e9 {
+		whole++
+		nanos = 0
+	}
+
+	return int64(whole), nanos
+}
+
+// pad2 formats a non-negative number with at least two digits
+func pad2(v int64) string {
+	if v < 10 {
+		return "0" + strconv.FormatInt(v, 10)
+	}
+
+	return strconv.FormatInt(v, 10)
+}
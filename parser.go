@@ -2,119 +2,172 @@ package isoduration
 
 import (
 	"strconv"
-	"strings"
 	"unicode"
+	"unicode/utf8"
+	"unsafe"
 )
 
-// parseLetter checks the found des, converts the types and fills the corresponding field of the pDuration input structure with a value.
-// If an error occurs in data processing, returns an error
-func parseLetter[DF designatorFunc, D *PeriodDuration | *TimeDuration](state, des rune, nums string, st D, desDef map[rune]DF) error {
-	if d, ok := desDef[des]; ok {
-		if nums == "" {
-			return NewDesignatorValueNotFoundError(state, des)
+// mark returns the mark of the designator in the state, nil if the designator is not supported in the state.
+// Designators outside the period are looked up as time designators
+func (d *Duration) mark(state, des rune) *float64 {
+	if state == PERIOD {
+		switch des {
+		case YEAR:
+			return &d.period.years
+		case MONTH:
+			return &d.period.months
+		case WEEK:
+			return &d.period.weeks
+		case DAY:
+			return &d.period.days
 		}
 
-		if v, err := strconv.ParseFloat(nums, 64); err != nil {
-			return NewIncorrectIsoFormatError(nums)
-		} else if state == PERIOD {
-			tDes := any(d).(periodDesignatorFunc)
-			tD := any(st).(*PeriodDuration)
+		return nil
+	}
 
-			if !tDes.checkSet(tD) {
-				tDes.set(tD, v)
+	switch des {
+	case HOUR:
+		return &d.time.hours
+	case MINUTE:
+		return &d.time.minutes
+	case SECOND:
+		return &d.time.seconds
+	}
 
-				return nil
-			}
-		} else if state == TIME {
-			tDes := any(d).(timeDesignatorFunc)
-			tD := any(st).(*TimeDuration)
+	return nil
+}
 
-			if !tDes.checkSet(tD) {
-				tDes.set(tD, v)
+// parseLetter checks the found des, converts the nums and sets the corresponding mark of the d input structure.
+// If an error occurs in data processing, returns an error
+func parseLetter(d *Duration, state, des rune, nums string) error {
+	mark := d.mark(state, des)
+	if mark == nil {
+		return NewIncorrectDesignatorError(state, des)
+	}
 
-				return nil
-			}
-		}
+	if nums == "" {
+		return NewDesignatorValueNotFoundError(state, des)
+	}
+
+	v, err := strconv.ParseFloat(nums, 64)
+	if err != nil {
+		return NewIncorrectIsoFormatError(nums)
+	}
+
+	if *mark != 0 {
 		return NewDesignatorMetError(des)
 	}
-	return NewIncorrectDesignatorError(state, des)
+
+	*mark = v
+
+	return nil
 }
 
-// parse parses an input string in ISO 8601 duration format without
-// a sign and returns *Duration and an error if the string could not be parsed
-func parse(duration string, multiplier float64) (*Duration, error) {
-	dt := &PeriodDuration{}
-	tm := &TimeDuration{}
+// parse parses an input string in ISO 8601 duration format without a sign into dst and returns an error
+// if the string could not be parsed. The tokens are slices of the input, so it does not allocate on success
+func parse(dst *Duration, duration string, multiplier float64) error {
 	state := rune(0)
 	fact := rune(0)
-	buffer := strings.Builder{}
+	// start is the offset of the token after the last designator
+	start := 0
 
 	for i, char := range duration {
 		switch {
 		case i == 0 && char == PERIOD:
 			state = PERIOD
+			start = i + 1
 		case state == PERIOD && char == TIME:
-			if buffer.String() != "" {
-				return nil, NewDesignatorNotFoundError(state, buffer.String())
+			if start != i {
+				return NewDesignatorNotFoundError(state, duration[start:i])
 			}
 			state = TIME
+			start = i + 1
 		case char == TIME || char == PERIOD:
-			return nil, IsNotIsoFormatError
+			return IsNotIsoFormatError
 		case unicode.IsLetter(char):
-			var err error
-
-			if state == PERIOD {
-				err = parseLetter(state, char, buffer.String(), dt, periodDesignatorsDef)
-			} else {
-				err = parseLetter(state, char, buffer.String(), tm, timeDesignatorsDef)
-			}
-
-			if err != nil {
-				return nil, err
+			if err := parseLetter(dst, state, char, duration[start:i]); err != nil {
+				return err
 			}
 			fact = state
-			buffer.Reset()
-		default:
-			buffer.WriteRune(char)
+			start = i + utf8.RuneLen(char)
 		}
 	}
 
 	if state == 0 {
-		return nil, IsNotIsoFormatError
-	} else if buffer.String() != "" {
-		return nil, NewDesignatorNotFoundError(state, buffer.String())
+		return IsNotIsoFormatError
+	} else if start != len(duration) {
+		return NewDesignatorNotFoundError(state, duration[start:])
 	} else if state == PERIOD && state != fact {
-		return nil, PeriodIsEmptyError
+		return PeriodIsEmptyError
 	} else if state == TIME && state != fact {
-		return nil, TimeIsEmptyError
+		return TimeIsEmptyError
 	}
 
-	return &Duration{
-		period:     dt,
-		time:       tm,
-		multiplier: multiplier,
-	}, nil
+	dst.multiplier = multiplier
+
+	return nil
 }
 
-// ParseDuration is the main method for parsing a string in ISO format.
-// Returns *Duration and an error if the string could not be parsed
+// splitSign removes the sign of a string in ISO 8601 duration format and returns the rest and its multiplier
+func splitSign(duration string) (string, float64) {
+	switch {
+	case duration == "":
+		return duration, 1
+	case duration[0] == '-':
+		return duration[1:], -1
+	case duration[0] == '+':
+		return duration[1:], 1
+	}
+
+	return duration, 1
+}
+
+// ParseInto parses a string in ISO format into dst like ParseDuration, but without heap allocations on success,
+// for hot paths that parse many durations. dst is changed only if the string is parsed.
+// Returns an error if the string could not be parsed
 // For example: P10Y5M2W1DT1H1.5M50S or -P10Y5M2W1DT1H1.5M50S
-func ParseDuration(duration string) (*Duration, error) {
+func ParseInto(dst *Duration, duration string) error {
 	if duration == "" {
-		return nil, IsNotIsoFormatError
+		return IsNotIsoFormatError
 	}
 
-	multiplier := float64(1)
+	var d Duration
+
+	rest, multiplier := splitSign(duration)
+	if err := parse(&d, rest, multiplier); err != nil {
+		return err
+	}
+
+	*dst = d
+
+	return nil
+}
 
-	switch prefix := duration[0]; string(prefix) {
-	case "-":
-		multiplier = -1
-		duration = duration[1:]
-	case "+":
-		duration = duration[1:]
+// ParseBytes parses bytes in ISO format like ParseDuration, but without heap allocations on success,
+// Duration is returned by value so that it does not escape. The bytes are not retained.
+// Returns Duration and an error if the bytes could not be parsed
+// For example: P10Y5M2W1DT1H1.5M50S or -P10Y5M2W1DT1H1.5M50S
+func ParseBytes(duration []byte) (Duration, error) {
+	var d Duration
+
+	// the string shares the bytes only while parsing, errors keep parts of the input, so they are made from a copy
+	if err := ParseInto(&d, unsafe.String(unsafe.SliceData(duration), len(duration))); err != nil {
+		return Duration{}, ParseInto(&d, string(duration))
+	}
+
+	return d, nil
+}
+
+// ParseDuration is the main method for parsing a string in ISO format.
+// Returns *Duration and an error if the string could not be parsed
+// For example: P10Y5M2W1DT1H1.5M50S or -P10Y5M2W1DT1H1.5M50S
+func ParseDuration(duration string) (*Duration, error) {
+	d := new(Duration)
+	if err := ParseInto(d, duration); err != nil {
+		return nil, err
 	}
 
-	return parse(duration, multiplier)
+	return d, nil
 }
 
 // MustParseDuration is the main method for parsing a string in ISO format. Returns *Duration.
@@ -0,0 +1,75 @@
+package isoduration
+
+// PluralCategory is a CLDR plural category that selects the grammatical form of a unit name
+type PluralCategory int
+
+// CLDR plural categories
+const (
+	PluralOther PluralCategory = iota
+	PluralZero
+	PluralOne
+	PluralTwo
+	PluralFew
+	PluralMany
+)
+
+// PluralRule selects the CLDR plural category of a number given by its CLDR operands:
+// i is the absolute integer part and v is the number of visible fraction digits.
+// For example: 1.5 has i = 1 and v = 1
+type PluralRule func(i int64, v int) PluralCategory
+
+// PluralOneOther is the CLDR rule of English, German and many other languages: one for 1 without fraction digits
+func PluralOneOther(i int64, v int) PluralCategory {
+	if i == 1 && v == 0 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+// PluralOtherOnly is the CLDR rule of languages without plural forms, such as Japanese
+func PluralOtherOnly(int64, int) PluralCategory {
+	return PluralOther
+}
+
+// PluralFrench is the CLDR rule of French: one for the integer part 0 and 1, many for whole millions
+func PluralFrench(i int64, v int) PluralCategory {
+	switch {
+	case i == 0 || i == 1:
+		return PluralOne
+	case v == 0 && i%1000000 == 0:
+		return PluralMany
+	}
+
+	return PluralOther
+}
+
+// PluralRussian is the CLDR rule of Russian and Ukrainian: one for 1, 21, 31, few for 2-4, 22-24, many for 0, 5-20, 25-30,
+// and other for numbers with fraction digits
+func PluralRussian(i int64, v int) PluralCategory {
+	switch {
+	case v != 0:
+		return PluralOther
+	case i%10 == 1 && i%100 != 11:
+		return PluralOne
+	case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+		return PluralFew
+	}
+
+	return PluralMany
+}
+
+// PluralPolish is the CLDR rule of Polish: one for 1 only, few for 2-4, 22-24, many for other integers,
+// and other for numbers with fraction digits
+func PluralPolish(i int64, v int) PluralCategory {
+	switch {
+	case v != 0:
+		return PluralOther
+	case i == 1:
+		return PluralOne
+	case i%10 >= 2 && i%10 <= 4 && (i%100 < 12 || i%100 > 14):
+		return PluralFew
+	}
+
+	return PluralMany
+}
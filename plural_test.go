@@ -0,0 +1,47 @@
+package isoduration
+
+import (
+	"testing"
+)
+
+func TestPluralRules(t *testing.T) {
+	tests := []struct {
+		name   string
+		rule   PluralRule
+		i      int64
+		v      int
+		result PluralCategory
+	}{
+		{"en", PluralOneOther, 1, 0, PluralOne},
+		{"en", PluralOneOther, 1, 1, PluralOther},
+		{"en", PluralOneOther, 0, 0, PluralOther},
+		{"ja", PluralOtherOnly, 1, 0, PluralOther},
+		{"fr", PluralFrench, 0, 0, PluralOne},
+		{"fr", PluralFrench, 1, 1, PluralOne},
+		{"fr", PluralFrench, 2, 0, PluralOther},
+		{"fr", PluralFrench, 2000000, 0, PluralMany},
+		{"ru", PluralRussian, 1, 0, PluralOne},
+		{"ru", PluralRussian, 21, 0, PluralOne},
+		{"ru", PluralRussian, 11, 0, PluralMany},
+		{"ru", PluralRussian, 2, 0, PluralFew},
+		{"ru", PluralRussian, 24, 0, PluralFew},
+		{"ru", PluralRussian, 12, 0, PluralMany},
+		{"ru", PluralRussian, 5, 0, PluralMany},
+		{"ru", PluralRussian, 0, 0, PluralMany},
+		{"ru", PluralRussian, 1, 1, PluralOther},
+		{"pl", PluralPolish, 1, 0, PluralOne},
+		{"pl", PluralPolish, 21, 0, PluralMany},
+		{"pl", PluralPolish, 22, 0, PluralFew},
+		{"pl", PluralPolish, 14, 0, PluralMany},
+		{"pl", PluralPolish, 2, 1, PluralOther},
+	}
+
+	for i, v := range tests {
+		switch r := v.rule(v.i, v.v); r {
+		case v.result:
+			t.Logf("Test %d (input: %s %d v=%d) completed successfully", i, v.name, v.i, v.v)
+		default:
+			t.Errorf("Test %d (input: %s %d v=%d) failed. Expected: %d. Result: %d", i, v.name, v.i, v.v, v.result, r)
+		}
+	}
+}
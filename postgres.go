@@ -0,0 +1,549 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// IntervalStyle defines the PostgreSQL IntervalStyle setting used for interval output
+type IntervalStyle int
+
+const (
+	// IntervalStylePostgres is the default PostgreSQL output style.
+	// For example: 1 year 2 mons 3 days 04:05:06
+	IntervalStylePostgres IntervalStyle = iota
+	// IntervalStylePostgresVerbose is the PostgreSQL verbose output style.
+	// For example: @ 1 year 2 mons 3 days 4 hours 5 mins 6 secs
+	IntervalStylePostgresVerbose
+	// IntervalStyleSQLStandard is the SQL standard output style.
+	// For example: 1-2 3 4:05:06
+	IntervalStyleSQLStandard
+	// IntervalStyleISO8601 is the ISO 8601 output style with a sign per component.
+	// For example: P1Y2M3DT4H5M6S
+	IntervalStyleISO8601
+)
+
+// postgresFormat is the format name used in errors
+const postgresFormat = "PostgreSQL interval"
+
+// microseconds in the time units, PostgreSQL keeps the time part with microsecond precision
+const (
+	pgSecond = int64(1000000)
+	pgMinute = 60 * pgSecond
+	pgHour   = 60 * pgMinute
+)
+
+// pgInterval is the PostgreSQL interval representation, months, days and microseconds are kept separately
+type pgInterval struct {
+	months int64
+	days   int64
+	micros int64
+}
+
+// pgInterval converts *Duration to the PostgreSQL interval representation.
+// Fractional months are carried into days and fractional days into microseconds, as PostgreSQL does on input
+func (d *Duration) pgInterval() pgInterval {
+	months := (d.period.years*12 + d.period.months) * d.multiplier
+	wholeMonths := math.Trunc(months)
+	days := (d.period.weeks*WeekDays+d.period.days)*d.multiplier + (months-wholeMonths)*MonthDays
+	wholeDays := math.Trunc(days)
+	micros := (days-wholeDays)*DayHours*float64(pgHour) +
+		(d.time.hours*float64(pgHour)+d.time.minutes*float64(pgMinute)+d.time.seconds*float64(pgSecond))*d.multiplier
+
+	return pgInterval{int64(wholeMonths), int64(wholeDays), int64(math.Round(micros))}
+}
+
+// pgClock splits signed microseconds into signed hours, minutes, seconds and microseconds
+func pgClock(micros int64) (hour, min, sec, fsec int64) {
+	return micros / pgHour, micros / pgMinute % 60, micros / pgSecond % 60, micros % pgSecond
+}
+
+// pgSeconds formats seconds with an optional fractional part without trailing zeros.
+// If fill is set, the whole seconds are padded to two digits
+func pgSeconds(sec, fsec int64, fill bool) string {
+	s := strconv.FormatInt(abs(sec), 10)
+
+	if fill && len(s) < 2 {
+		s = "0" + s
+	}
+
+	return s + formatFraction(abs(fsec), pgSecond)
+}
+
+// FormatPostgresInterval represents *Duration as a PostgreSQL interval string in the given style
+// For example: 1 year 2 mons 3 days 04:05:06
+func (d *Duration) FormatPostgresInterval(style IntervalStyle) string {
+	iv := d.pgInterval()
+	year, mon := iv.months/12, iv.months%12
+	hour, min, sec, fsec := pgClock(iv.micros)
+
+	switch style {
+	case IntervalStylePostgresVerbose:
+		return formatPostgresVerbose(year, mon, iv.days, hour, min, sec, fsec)
+	case IntervalStyleSQLStandard:
+		return formatSQLStandard(year, mon, iv.days, hour, min, sec, fsec)
+	case IntervalStyleISO8601:
+		return formatPostgresISO8601(year, mon, iv.days, hour, min, sec, fsec)
+	default:
+		return formatPostgres(year, mon, iv.days, hour, min, sec, fsec)
+	}
+}
+
+// formatPostgres formats the interval marks in the postgres style
+func formatPostgres(year, mon, day, hour, min, sec, fsec int64) string {
+	b := strings.Builder{}
+	isZero := true
+	isBefore := false
+
+	add := func(v int64, unit string) {
+		if v == 0 {
+			return
+		}
+		if !isZero {
+			b.WriteByte(' ')
+		}
+		if isBefore && v > 0 {
+			b.WriteByte('+')
+		}
+		b.WriteString(strconv.FormatInt(v, 10) + " " + unit)
+		if v != 1 {
+			b.WriteByte('s')
+		}
+		isBefore = v < 0
+		isZero = false
+	}
+
+	add(year, "year")
+	add(mon, "mon")
+	add(day, "day")
+
+	if isZero || hour != 0 || min != 0 || sec != 0 || fsec != 0 {
+		minus := hour < 0 || min < 0 || sec < 0 || fsec < 0
+
+		if !isZero {
+			b.WriteByte(' ')
+		}
+		if minus {
+			b.WriteByte('-')
+		} else if isBefore {
+			b.WriteByte('+')
+		}
+		b.WriteString(pgSeconds(hour, 0, true) + ":" + pgSeconds(min, 0, true) + ":" + pgSeconds(sec, fsec, true))
+	}
+
+	return b.String()
+}
+
+// formatPostgresVerbose formats the interval marks in the postgres_verbose style
+func formatPostgresVerbose(year, mon, day, hour, min, sec, fsec int64) string {
+	b := strings.Builder{}
+	isZero := true
+	isBefore := false
+
+	b.WriteByte('@')
+
+	add := func(v int64, unit string) {
+		if v == 0 {
+			return
+		}
+		if isZero {
+			isBefore = v < 0
+			v = abs(v)
+		} else if isBefore {
+			v = -v
+		}
+		b.WriteString(" " + strconv.FormatInt(v, 10) + " " + unit)
+		if v != 1 {
+			b.WriteByte('s')
+		}
+		isZero = false
+	}
+
+	add(year, "year")
+	add(mon, "mon")
+	add(day, "day")
+	add(hour, "hour")
+	add(min, "min")
+
+	if sec != 0 || fsec != 0 {
+		b.WriteByte(' ')
+		if sec < 0 || (sec == 0 && fsec < 0) {
+			if isZero {
+				isBefore = true
+			} else if !isBefore {
+				b.WriteByte('-')
+			}
+		} else if isBefore {
+			b.WriteByte('-')
+		}
+		b.WriteString(pgSeconds(sec, fsec, false) + " sec")
+		if abs(sec) != 1 || fsec != 0 {
+			b.WriteByte('s')
+		}
+		isZero = false
+	}
+
+	if isZero {
+		b.WriteString(" 0")
+	}
+	if isBefore {
+		b.WriteString(" ago")
+	}
+
+	return b.String()
+}
+
+// formatSQLStandard formats the interval marks in the sql_standard style
+func formatSQLStandard(year, mon, day, hour, min, sec, fsec int64) string {
+	hasNegative := year < 0 || mon < 0 || day < 0 || hour < 0 || min < 0 || sec < 0 || fsec < 0
+	hasPositive := year > 0 || mon > 0 || day > 0 || hour > 0 || min > 0 || sec > 0 || fsec > 0
+	hasYearMonth := year != 0 || mon != 0
+	hasDayTime := day != 0 || hour != 0 || min != 0 || sec != 0 || fsec != 0
+	isStandard := !(hasNegative && hasPositive) && !(hasYearMonth && hasDayTime)
+	prefix := ""
+
+	sign := func(negative bool) string {
+		if negative {
+			return "-"
+		}
+		return "+"
+	}
+	clock := func() string {
+		return strconv.FormatInt(abs(hour), 10) + ":" + pgSeconds(min, 0, true) + ":" + pgSeconds(sec, fsec, true)
+	}
+
+	if isStandard && hasNegative {
+		prefix = "-"
+	}
+
+	switch {
+	case !hasNegative && !hasPositive:
+		return "0"
+	case !isStandard:
+		return sign(year < 0 || mon < 0) + strconv.FormatInt(abs(year), 10) + "-" + strconv.FormatInt(abs(mon), 10) +
+			" " + sign(day < 0) + strconv.FormatInt(abs(day), 10) +
+			" " + sign(hour < 0 || min < 0 || sec < 0 || fsec < 0) + clock()
+	case hasYearMonth:
+		return prefix + strconv.FormatInt(abs(year), 10) + "-" + strconv.FormatInt(abs(mon), 10)
+	case day != 0:
+		return prefix + strconv.FormatInt(abs(day), 10) + " " + clock()
+	default:
+		return prefix + clock()
+	}
+}
+
+// formatPostgresISO8601 formats the interval marks in the iso_8601 style
+func formatPostgresISO8601(year, mon, day, hour, min, sec, fsec int64) string {
+	if year == 0 && mon == 0 && day == 0 && hour == 0 && min == 0 && sec == 0 && fsec == 0 {
+		return "PT0S"
+	}
+
+	b := strings.Builder{}
+
+	add := func(v int64, designator rune) {
+		if v != 0 {
+			b.WriteString(strconv.FormatInt(v, 10))
+			b.WriteRune(designator)
+		}
+	}
+
+	b.WriteRune(PERIOD)
+	add(year, YEAR)
+	add(mon, MONTH)
+	add(day, DAY)
+
+	if hour != 0 || min != 0 || sec != 0 || fsec != 0 {
+		b.WriteRune(TIME)
+	}
+
+	add(hour, HOUR)
+	add(min, MINUTE)
+
+	if sec != 0 || fsec != 0 {
+		if sec < 0 || fsec < 0 {
+			b.WriteByte('-')
+		}
+		b.WriteString(pgSeconds(sec, fsec, false))
+		b.WriteRune(SECOND)
+	}
+
+	return b.String()
+}
+
+// ParsePostgresInterval parses a PostgreSQL interval string in any of the IntervalStyle output styles.
+// The style is detected from the string itself, so the session IntervalStyle setting does not matter.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1 year 2 mons 3 days 04:05:06, @ 1 year 2 mons ago, 1-2 3 4:05:06 or P1Y2M3DT4H5M6S
+func ParsePostgresInterval(interval string) (*Duration, error) {
+	interval = strings.TrimSpace(interval)
+
+	switch {
+	case interval == "":
+		return nil, NewIncorrectFormatError(postgresFormat, interval)
+	case interval[0] == '@':
+		return parsePostgresUnits(interval[1:], true)
+	case strings.ContainsRune(interval, PERIOD):
+		return parsePostgresISO8601(interval)
+	case strings.IndexFunc(interval, isLetter) >= 0:
+		return parsePostgresUnits(interval, false)
+	default:
+		return parseSQLStandard(interval)
+	}
+}
+
+// isLetter checks that r is an ASCII letter
+func isLetter(r rune) bool {
+	return (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z')
+}
+
+// parsePostgresUnits parses the postgres and postgres_verbose styles, which are "number unit" pairs
+// optionally followed by a clock in the postgres style or by "ago" in the postgres_verbose style
+func parsePostgresUnits(interval string, verbose bool) (*Duration, error) {
+	var marks [7]float64
+	var met [7]bool
+	fields := strings.Fields(interval)
+
+	ago := verbose && len(fields) > 0 && fields[len(fields)-1] == "ago"
+
+	if ago {
+		fields = fields[:len(fields)-1]
+	}
+
+	if verbose && len(fields) == 1 && fields[0] == "0" {
+		return NewDuration(0, 0, 0, 0, 0, 0, 0, false), nil
+	}
+
+	for i := 0; i < len(fields); i++ {
+		if !verbose && i == len(fields)-1 && strings.ContainsRune(fields[i], ':') {
+			h, m, s, err := parseClock(fields[i], postgresFormat)
+			if err != nil {
+				return nil, err
+			}
+			marks[4], marks[5], marks[6] = h, m, s
+			break
+		}
+
+		if i+1 == len(fields) {
+			return nil, NewIncorrectFormatError(postgresFormat, fields[i])
+		}
+
+		v, err := strconv.ParseFloat(fields[i], 64)
+		if err != nil {
+			return nil, NewIncorrectFormatError(postgresFormat, fields[i])
+		}
+
+		idx := -1
+		switch strings.TrimSuffix(fields[i+1], "s") {
+		case "year":
+			idx = 0
+		case "mon":
+			idx = 1
+		case "day":
+			idx = 2
+		case "hour":
+			idx = 4
+		case "min":
+			idx = 5
+		case "sec":
+			idx = 6
+		}
+
+		if idx < 0 || met[idx] {
+			return nil, NewIncorrectFormatError(postgresFormat, fields[i+1])
+		}
+
+		marks[idx], met[idx] = v, true
+		i++
+	}
+
+	if ago {
+		for i := range marks {
+			marks[i] = 0 - marks[i]
+		}
+	}
+
+	return newSignedDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6]), nil
+}
+
+// parseClock parses a signed [-]H:MM[:SS[.ffffff]] clock into signed hours, minutes and seconds
+func parseClock(clock, format string) (hours, minutes, seconds float64, err error) {
+	sign := float64(1)
+	in := clock
+
+	switch {
+	case strings.HasPrefix(clock, "-"):
+		sign = -1
+		clock = clock[1:]
+	case strings.HasPrefix(clock, "+"):
+		clock = clock[1:]
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, 0, 0, NewIncorrectFormatError(format, in)
+	}
+
+	values := [3]float64{}
+	for i, p := range parts {
+		if p == "" || p[0] == '-' || p[0] == '+' {
+			return 0, 0, 0, NewIncorrectFormatError(format, in)
+		}
+
+		if values[i], err = strconv.ParseFloat(p, 64); err != nil || (i < 2 && strings.ContainsRune(p, '.')) {
+			return 0, 0, 0, NewIncorrectFormatError(format, in)
+		}
+	}
+
+	return 0 + sign*values[0], 0 + sign*values[1], 0 + sign*values[2], nil
+}
+
+// parseSQLStandard parses the sql_standard style, a leading sign applies to every field without an explicit sign
+func parseSQLStandard(interval string) (*Duration, error) {
+	var years, months, days, hours, minutes, seconds float64
+	fields := strings.Fields(interval)
+	negative := strings.HasPrefix(interval, "-")
+
+	if interval == "0" {
+		return NewDuration(0, 0, 0, 0, 0, 0, 0, false), nil
+	}
+
+	// signed applies the leading sign to fields that have no sign of their own
+	signed := func(field string) string {
+		if negative && field[0] != '-' && field[0] != '+' {
+			return "-" + field
+		}
+		return field
+	}
+
+	yearMonth := func(field string) error {
+		sign := float64(1)
+		in := field
+
+		switch field[0] {
+		case '-':
+			sign = -1
+			field = field[1:]
+		case '+':
+			field = field[1:]
+		}
+
+		y, m, ok := strings.Cut(field, "-")
+		if !ok {
+			return NewIncorrectFormatError(postgresFormat, in)
+		}
+
+		yv, err := strconv.ParseUint(y, 10, 63)
+		if err != nil {
+			return NewIncorrectFormatError(postgresFormat, in)
+		}
+		mv, err := strconv.ParseUint(m, 10, 63)
+		if err != nil {
+			return NewIncorrectFormatError(postgresFormat, in)
+		}
+
+		years, months = sign*float64(yv), sign*float64(mv)
+		return nil
+	}
+
+	day := func(field string) (err error) {
+		if days, err = strconv.ParseFloat(field, 64); err != nil || strings.ContainsRune(field, '.') {
+			return NewIncorrectFormatError(postgresFormat, field)
+		}
+		return nil
+	}
+
+	var err error
+	switch len(fields) {
+	case 1:
+		if strings.ContainsRune(fields[0], ':') {
+			hours, minutes, seconds, err = parseClock(fields[0], postgresFormat)
+		} else {
+			err = yearMonth(fields[0])
+		}
+	case 2:
+		if err = day(fields[0]); err == nil {
+			hours, minutes, seconds, err = parseClock(signed(fields[1]), postgresFormat)
+		}
+	case 3:
+		if err = yearMonth(fields[0]); err == nil {
+			if err = day(signed(fields[1])); err == nil {
+				hours, minutes, seconds, err = parseClock(signed(fields[2]), postgresFormat)
+			}
+		}
+	default:
+		err = NewIncorrectFormatError(postgresFormat, interval)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return newSignedDuration(years, months, days, 0, hours, minutes, seconds), nil
+}
+
+// parsePostgresISO8601 parses the iso_8601 style, where every component may carry its own sign.
+// As in ParseDuration, every designator may be used only once
+func parsePostgresISO8601(interval string) (*Duration, error) {
+	if !strings.ContainsRune(interval[1:], '-') {
+		return ParseDuration(interval)
+	}
+
+	var marks [7]float64
+	var met [7]bool
+	state := rune(0)
+	start := 0
+
+	for i, char := range interval {
+		switch {
+		case i == 0 && char == PERIOD:
+			state = PERIOD
+			start = i + 1
+		case state == PERIOD && char == TIME:
+			state = TIME
+			start = i + 1
+		case state != 0 && isLetter(char):
+			v, err := strconv.ParseFloat(interval[start:i], 64)
+			if err != nil {
+				return nil, NewIncorrectFormatError(postgresFormat, interval[start:i])
+			}
+
+			idx := -1
+			switch {
+			case state == PERIOD && char == YEAR:
+				idx = 0
+			case state == PERIOD && char == MONTH:
+				idx = 1
+			case state == PERIOD && char == DAY:
+				idx = 2
+			case state == PERIOD && char == WEEK:
+				idx = 3
+			case state == TIME && char == HOUR:
+				idx = 4
+			case state == TIME && char == MINUTE:
+				idx = 5
+			case state == TIME && char == SECOND:
+				idx = 6
+			}
+
+			if idx < 0 {
+				return nil, NewIncorrectDesignatorError(state, char)
+			}
+
+			if met[idx] {
+				return nil, NewDesignatorMetError(char)
+			}
+
+			marks[idx], met[idx] = v, true
+			start = i + 1
+		case state == 0:
+			return nil, NewIncorrectFormatError(postgresFormat, interval)
+		}
+	}
+
+	if start != len(interval) {
+		return nil, NewDesignatorNotFoundError(state, interval[start:])
+	}
+
+	return newSignedDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6]), nil
+}
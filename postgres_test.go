@@ -0,0 +1,196 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestFormatPostgresInterval(t *testing.T) {
+	tests := []struct {
+		input  *Duration
+		style  IntervalStyle
+		result string
+	}{
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+			style:  IntervalStylePostgres,
+			result: "1 year 2 mons 3 days 04:05:06",
+		},
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 5, 6, true),
+			style:  IntervalStylePostgres,
+			result: "-1 years -2 mons -3 days -04:05:06",
+		},
+		{
+			input:  NewDuration(0, 14, 1, 1, 0, 90, 0.5, false),
+			style:  IntervalStylePostgres,
+			result: "1 year 2 mons 8 days 01:30:00.5",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			style:  IntervalStylePostgres,
+			result: "00:00:00",
+		},
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+			style:  IntervalStylePostgresVerbose,
+			result: "@ 1 year 2 mons 3 days 4 hours 5 mins 6 secs",
+		},
+		{
+			input:  NewDuration(1, 2, 0, 0, 0, 0, 1, true),
+			style:  IntervalStylePostgresVerbose,
+			result: "@ 1 year 2 mons 1 sec ago",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			style:  IntervalStylePostgresVerbose,
+			result: "@ 0",
+		},
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+			style:  IntervalStyleSQLStandard,
+			result: "+1-2 +3 +4:05:06",
+		},
+		{
+			input:  NewDuration(1, 2, 0, 0, 0, 0, 0, true),
+			style:  IntervalStyleSQLStandard,
+			result: "-1-2",
+		},
+		{
+			input:  NewDuration(0, 0, 3, 0, 4, 5, 6.25, true),
+			style:  IntervalStyleSQLStandard,
+			result: "-3 4:05:06.25",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			style:  IntervalStyleSQLStandard,
+			result: "0",
+		},
+		{
+			input:  NewDuration(1, 2, 3, 0, 4, 5, 6, true),
+			style:  IntervalStyleISO8601,
+			result: "P-1Y-2M-3DT-4H-5M-6S",
+		},
+		{
+			input:  NewDuration(0, 1.5, 0, 0, 0, 0, 0, false),
+			style:  IntervalStyleISO8601,
+			result: "P1M15D",
+		},
+	}
+
+	for i, v := range tests {
+		switch r := v.input.FormatPostgresInterval(v.style); r {
+		case v.result:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
+
+func TestParsePostgresInterval(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1 year 2 mons 3 days 04:05:06",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+		},
+		{
+			input:  "-1 years -2 mons -3 days -04:05:06.5",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6.5, true),
+		},
+		{
+			input:  "-1 years +2 mons",
+			result: NewDuration(-1, 2, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "00:00:00",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "@ 1 year 2 mons 3 days 4 hours 5 mins 6 secs",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+		},
+		{
+			input:  "@ 1 year 2 mons 1 sec ago",
+			result: NewDuration(1, 2, 0, 0, 0, 0, 1, true),
+		},
+		{
+			input:  "1-2",
+			result: NewDuration(1, 2, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "-3 4:05:06",
+			result: NewDuration(0, 0, 3, 0, 4, 5, 6, true),
+		},
+		{
+			input:  "+1-2 +3 +4:05:06",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+		},
+		{
+			input:  "0",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "P-1Y-2M-3DT-4H-5M-6S",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, true),
+		},
+		{
+			input:  "P1Y2M3DT4H5M6S",
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6, false),
+		},
+		{
+			input:   "P-1Y-2M-1Y",
+			isError: true,
+			err:     NewDesignatorMetError(YEAR),
+		},
+		{
+			input:   "PT-1M0H-0M",
+			isError: true,
+			err:     NewDesignatorMetError(MINUTE),
+		},
+		{
+			input:   "P1Y1Y",
+			isError: true,
+			err:     NewDesignatorMetError(YEAR),
+		},
+		{
+			input:   "1 yaer",
+			isError: true,
+			err:     NewIncorrectFormatError(postgresFormat, "yaer"),
+		},
+		{
+			input:   "1 year 2 years",
+			isError: true,
+			err:     NewIncorrectFormatError(postgresFormat, "years"),
+		},
+		{
+			input:   "1:2:3:4",
+			isError: true,
+			err:     NewIncorrectFormatError(postgresFormat, "1:2:3:4"),
+		},
+		{
+			input:   "",
+			isError: true,
+			err:     NewIncorrectFormatError(postgresFormat, ""),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParsePostgresInterval(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package isoduration
+
+import (
+	"math"
+)
+
+// prometheusFormat is the format name used in errors
+const prometheusFormat = "Prometheus"
+
+// Prometheus units
+var (
+	// prometheusUnits defines the units accepted by the Prometheus duration syntax in their required order
+	prometheusUnits = []unitMark{
+		{"y", yearsMark, 1},
+		{"w", weeksMark, 1},
+		{"d", daysMark, 1},
+		{"h", hoursMark, 1},
+		{"m", minutesMark, 1},
+		{"s", secondsMark, 1},
+		{"ms", secondsMark, 1e-3},
+	}
+	// prometheusSizes defines the units used to format the Prometheus duration syntax, sizes are in milliseconds
+	prometheusSizes = []unitSize{
+		{"y", 1000 * 60 * 60 * DayHours * YearDays, true},
+		{"w", 1000 * 60 * 60 * DayHours * WeekDays, true},
+		{"d", 1000 * 60 * 60 * DayHours, false},
+		{"h", 1000 * 60 * 60, false},
+		{"m", 1000 * 60, false},
+		{"s", 1000, false},
+		{"ms", 1, false},
+	}
+)
+
+// ParsePrometheus parses a duration in the Prometheus syntax, integer values in the order y, w, d, h, m, s, ms.
+// Years, weeks and days are kept in their own marks, milliseconds are added to seconds.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1y2w3d4h5m6s7ms or 90d
+func ParsePrometheus(duration string) (*Duration, error) {
+	if duration == "0" {
+		return NewDuration(0, 0, 0, 0, 0, 0, 0, false), nil
+	}
+
+	return parseOrderedUnits(duration, prometheusFormat, prometheusUnits, false)
+}
+
+// FormatPrometheus represents *Duration in the Prometheus syntax the way Prometheus does, a year is 365 days and
+// years and weeks are used only if they divide the duration without a remainder. The duration is truncated to milliseconds.
+// Returns an error if the duration has months
+// For example: 1y2w3d4h5m6s7ms or 90d
+func (d *Duration) FormatPrometheus() (string, error) {
+	if d.period.months != 0 {
+		return "", NewUnsupportedDesignatorError(prometheusFormat, MONTH)
+	}
+
+	// rounding to nanoseconds first keeps values like 6.007 seconds from being truncated to 6006 milliseconds
+	ms := math.Trunc(math.Round((d.period.years*YearDays*DayHours*3600*d.multiplier+d.exactSeconds())*1e9) / 1e6)
+
+	switch {
+	case math.Abs(ms) >= math.MaxInt64:
+		return "", DurationOverflowError
+	case ms == 0:
+		return "0s", nil
+	case ms < 0:
+		return "-" + formatUnits(int64(-ms), prometheusSizes, ""), nil
+	}
+
+	return formatUnits(int64(ms), prometheusSizes, ""), nil
+}
@@ -0,0 +1,110 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParsePrometheus(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1y2w3d4h5m6s7ms",
+			result: NewDuration(1, 0, 3, 2, 4, 5, 6.007, false),
+		},
+		{
+			input:  "0",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "-90m",
+			result: NewDuration(0, 0, 0, 0, 0, 90, 0, true),
+		},
+		{
+			input:   "5m1h",
+			isError: true,
+			err:     NewIncorrectFormatError(prometheusFormat, "1h"),
+		},
+		{
+			input:   "1.5h",
+			isError: true,
+			err:     NewIncorrectFormatError(prometheusFormat, "1.5h"),
+		},
+		{
+			input:   "1h 5m",
+			isError: true,
+			err:     NewIncorrectFormatError(prometheusFormat, "1h 5m"),
+		},
+		{
+			input:   "",
+			isError: true,
+			err:     NewIncorrectFormatError(prometheusFormat, ""),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParsePrometheus(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatPrometheus(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(1, 0, 3, 2, 4, 5, 6.007, false),
+			result: "382d4h5m6s7ms",
+		},
+		{
+			input:  NewDuration(1, 0, 0, 0, 0, 0, 0, false),
+			result: "1y",
+		},
+		{
+			input:  NewDuration(0, 0, 90, 0, 0, 0, 0, false),
+			result: "90d",
+		},
+		{
+			input:  NewDuration(0, 0, 14, 0, 0, 0, 0, true),
+			result: "-2w",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0.0001, false),
+			result: "0s",
+		},
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(prometheusFormat, MONTH),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatPrometheus()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
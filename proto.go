@@ -0,0 +1,165 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// protoFormat is the format name used in errors
+const protoFormat = "protobuf Duration"
+
+// protoMaxSeconds is the range of google.protobuf.Duration seconds, about 10000 years
+const protoMaxSeconds = 315576000000
+
+// secondsNanos returns the signed length of the exact marks of *Duration as whole seconds rounded down
+// and nanoseconds in the range [0, 1e9). Each mark is split separately, so large marks do not lose the nanoseconds
+func (d *Duration) secondsNanos() (int64, int64) {
+	var seconds, nanos int64
+
+	for _, v := range [5]float64{
+		d.period.weeks * WeekDays * DayHours * 3600,
+		d.period.days * DayHours * 3600,
+		d.time.hours * 3600,
+		d.time.minutes * 60,
+		d.time.seconds,
+	} {
+		s, n := splitSeconds(v * d.multiplier)
+		seconds, nanos = seconds+s, nanos+n
+	}
+
+	return seconds + nanos/1e9, nanos % 1e9
+}
+
+// toProto converts whole seconds rounded down and nanoseconds in the range [0, 1e9) into the google.protobuf.Duration
+// representation, where nanos has the sign of seconds, and checks its range
+func toProto(seconds, nanos int64) (int64, int32, error) {
+	if seconds < 0 && nanos > 0 {
+		seconds++
+		nanos -= 1e9
+	}
+
+	if abs(seconds) > protoMaxSeconds {
+		return 0, 0, DurationOverflowError
+	}
+
+	return seconds, int32(nanos), nil
+}
+
+// ToProto converts *Duration into the seconds and nanos fields of google.protobuf.Duration, weeks and days are counted
+// as 168 and 24 hours. The duration is rounded to nanoseconds.
+// Returns an error if the duration has nominal marks, use ToProtoAt for them, or is out of range
+func (d *Duration) ToProto() (seconds int64, nanos int32, err error) {
+	switch {
+	case d.period.years != 0:
+		return 0, 0, NewUnsupportedDesignatorError(protoFormat, YEAR)
+	case d.period.months != 0:
+		return 0, 0, NewUnsupportedDesignatorError(protoFormat, MONTH)
+	case math.Abs(d.exactSeconds()) > protoMaxSeconds+1:
+		return 0, 0, DurationOverflowError
+	}
+
+	return toProto(d.secondsNanos())
+}
+
+// ToProtoAt converts *Duration into the seconds and nanos fields of google.protobuf.Duration as the exact time between
+// ref and ref with the duration added by AddTo, so nominal marks get the length they have at ref.
+// Returns an error if the duration is out of range
+func (d *Duration) ToProtoAt(ref time.Time) (seconds int64, nanos int32, err error) {
+	end := d.AddTo(ref)
+	diff := int64(end.Nanosecond() - ref.Nanosecond())
+
+	return toProto(end.Unix()-ref.Unix()+int64(math.Floor(float64(diff)/1e9)), (diff%1e9+1e9)%1e9)
+}
+
+// NewFromProto creates new *Duration from the seconds and nanos fields of google.protobuf.Duration,
+// the result has only hours, minutes and seconds marks.
+// Returns an error if the values are out of range or have different signs
+func NewFromProto(seconds int64, nanos int32) (*Duration, error) {
+	switch {
+	case abs(seconds) > protoMaxSeconds || nanos <= -1e9 || nanos >= 1e9:
+		return nil, DurationOverflowError
+	case (seconds < 0 && nanos > 0) || (seconds > 0 && nanos < 0):
+		return nil, MixedSignsError
+	}
+
+	isNegative := seconds < 0 || nanos < 0
+	seconds, n := abs(seconds), abs(int64(nanos))
+
+	return NewDuration(0, 0, 0, 0, float64(seconds/3600), float64(seconds/60%60), float64(seconds%60)+float64(n)/1e9, isNegative), nil
+}
+
+// ParseProtoJSON parses the JSON mapping of google.protobuf.Duration: decimal seconds with up to 9 fraction digits
+// followed by the s suffix. Returns *Duration and an error if the string could not be parsed
+// For example: 3s, -0.5s or 3.000000001s
+func ParseProtoJSON(duration string) (*Duration, error) {
+	str, isNegative := strings.CutPrefix(duration, "-")
+	str, ok := strings.CutSuffix(str, "s")
+	whole, frac, hasFrac := strings.Cut(str, ".")
+
+	if !ok || (hasFrac && (frac == "" || len(frac) > 9)) || whole == "" || strings.ContainsAny(whole+frac, "+-") {
+		return nil, NewIncorrectFormatError(protoFormat, duration)
+	}
+
+	seconds, err := strconv.ParseUint(whole, 10, 63)
+	if err != nil {
+		return nil, NewIncorrectFormatError(protoFormat, duration)
+	}
+
+	nanos := uint64(0)
+	if hasFrac {
+		if nanos, err = strconv.ParseUint(frac+strings.Repeat("0", 9-len(frac)), 10, 32); err != nil {
+			return nil, NewIncorrectFormatError(protoFormat, duration)
+		}
+	}
+
+	if seconds > protoMaxSeconds {
+		return nil, DurationOverflowError
+	}
+
+	if isNegative {
+		return NewFromProto(-int64(seconds), -int32(nanos))
+	}
+
+	return NewFromProto(int64(seconds), int32(nanos))
+}
+
+// formatProtoJSON represents the seconds and nanos fields of google.protobuf.Duration in its JSON mapping
+// with 0, 3, 6 or 9 fraction digits
+func formatProtoJSON(seconds int64, nanos int32) string {
+	sign := ""
+	if seconds < 0 || nanos < 0 {
+		sign = "-"
+	}
+
+	str := strconv.FormatInt(abs(seconds), 10) + "." + strconv.FormatInt(abs(int64(nanos))+1e9, 10)[1:]
+	str = strings.TrimSuffix(strings.TrimSuffix(strings.TrimSuffix(str, "000"), "000"), ".000")
+
+	return sign + str + "s"
+}
+
+// FormatProtoJSON represents *Duration in the JSON mapping of google.protobuf.Duration the way protojson does,
+// with 0, 3, 6 or 9 fraction digits. Returns an error if the duration has nominal marks, use FormatProtoJSONAt for them,
+// or is out of range
+// For example: 3s, -0.500s or 3.000000001s
+func (d *Duration) FormatProtoJSON() (string, error) {
+	seconds, nanos, err := d.ToProto()
+	if err != nil {
+		return "", err
+	}
+
+	return formatProtoJSON(seconds, nanos), nil
+}
+
+// FormatProtoJSONAt represents *Duration in the JSON mapping of google.protobuf.Duration like FormatProtoJSON,
+// but nominal marks get the length they have at ref, as in ToProtoAt. Returns an error if the duration is out of range
+// For example: P1M at 2024-02-01 gives 2505600s
+func (d *Duration) FormatProtoJSONAt(ref time.Time) (string, error) {
+	seconds, nanos, err := d.ToProtoAt(ref)
+	if err != nil {
+		return "", err
+	}
+
+	return formatProtoJSON(seconds, nanos), nil
+}
@@ -0,0 +1,206 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestToProto(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		seconds int64
+		nanos   int32
+		isError bool
+		err     error
+	}{
+		{
+			input:   NewDuration(0, 0, 1, 0, 1, 0, 3.000000001, false),
+			seconds: 90003,
+			nanos:   1,
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 1, 0.5, true),
+			seconds: -60,
+			nanos:   -500000000,
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1e6, 0, 0.000000001, false),
+			seconds: 3600000000,
+			nanos:   1,
+		},
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(protoFormat, MONTH),
+		},
+		{
+			input:   NewDuration(0, 0, 0, 1e6, 0, 0, 0, false),
+			isError: true,
+			err:     DurationOverflowError,
+		},
+	}
+
+	for i, v := range tests {
+		seconds, nanos, err := v.input.ToProto()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && seconds == v.seconds && nanos == v.nanos:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %d %d. Result: %d %d", i, v.input, v.seconds, v.nanos, seconds, nanos)
+		}
+	}
+}
+
+func TestToProtoAt(t *testing.T) {
+	ref := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input   *Duration
+		seconds int64
+		nanos   int32
+	}{
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			seconds: 29 * 86400,
+		},
+		{
+			input:   NewDuration(1, 0, 0, 0, 0, 0, 0, true),
+			seconds: -365 * 86400,
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 0, 0.25, true),
+			seconds: 0,
+			nanos:   -250000000,
+		},
+	}
+
+	for i, v := range tests {
+		seconds, nanos, err := v.input.ToProtoAt(ref)
+
+		switch {
+		case err == nil && seconds == v.seconds && nanos == v.nanos:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %d %d. Result: %d %d", i, v.input, v.seconds, v.nanos, seconds, nanos)
+		}
+	}
+}
+
+func TestNewFromProto(t *testing.T) {
+	tests := []struct {
+		seconds int64
+		nanos   int32
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			seconds: 3723,
+			nanos:   500000000,
+			result:  NewDuration(0, 0, 0, 0, 1, 2, 3.5, false),
+		},
+		{
+			seconds: -1,
+			nanos:   -500000000,
+			result:  NewDuration(0, 0, 0, 0, 0, 0, 1.5, true),
+		},
+		{
+			seconds: 1,
+			nanos:   -1,
+			isError: true,
+			err:     MixedSignsError,
+		},
+		{
+			seconds: 0,
+			nanos:   1e9,
+			isError: true,
+			err:     DurationOverflowError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := NewFromProto(v.seconds, v.nanos)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %d %d) completed successfully", i, v.seconds, v.nanos)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %d %d) completed successfully", i, v.seconds, v.nanos)
+		default:
+			t.Errorf("Test %d (input: %d %d) failed. Expected: %s. Result: %s", i, v.seconds, v.nanos, v.result, result)
+		}
+	}
+}
+
+func TestProtoJSON(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  string
+		isError bool
+		err     error
+	}{
+		{input: "3s", result: "3s"},
+		{input: "3.000000001s", result: "3.000000001s"},
+		{input: "-0.5s", result: "-0.500s"},
+		{input: "1.25s", result: "1.250s"},
+		{input: "3600.000010s", result: "3600.000010s"},
+		{input: "3", isError: true, err: NewIncorrectFormatError(protoFormat, "3")},
+		{input: "+3s", isError: true, err: NewIncorrectFormatError(protoFormat, "+3s")},
+		{input: "1.0000000001s", isError: true, err: NewIncorrectFormatError(protoFormat, "1.0000000001s")},
+		{input: "315576000001s", isError: true, err: DurationOverflowError},
+	}
+
+	for i, v := range tests {
+		var result string
+		d, err := ParseProtoJSON(v.input)
+		if err == nil {
+			result, err = d.FormatProtoJSON()
+		}
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatProtoJSONAt(t *testing.T) {
+	ref := time.Date(2024, time.February, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{input: NewDuration(0, 1, 0, 0, 0, 0, 0, false), result: "2505600s"},
+		{input: NewDuration(1, 0, 0, 0, 0, 0, 0, true), result: "-31536000s"},
+		{input: NewDuration(0, 1, 0, 0, 0, 0, 0.5, false), result: "2505600.500s"},
+		{input: NewDuration(0, 0, 0, 0, 0, 0, 3.000000001, false), result: "3.000000001s"},
+		{input: NewDuration(20000, 0, 0, 0, 0, 0, 0, false), isError: true, err: DurationOverflowError},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatProtoJSONAt(ref)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+
+	if _, err := NewDuration(0, 1, 0, 0, 0, 0, 0, false).FormatProtoJSON(); !errors.Is(err, NewUnsupportedDesignatorError(protoFormat, MONTH)) {
+		t.Errorf("FormatProtoJSON of P1M failed. Expected: %s. Result: %v", NewUnsupportedDesignatorError(protoFormat, MONTH), err)
+	}
+}
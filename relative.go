@@ -0,0 +1,124 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RelativeThresholds defines the units of relative phrases: a duration shorter than a threshold is rendered
+// in the unit of that threshold, a longer one in the next unit. Zero thresholds are skipped
+type RelativeThresholds struct {
+	// JustNow is the length below which a duration is rendered as just now
+	JustNow time.Duration
+	// Seconds, Minutes, Hours, Days and Months are the lengths below which a duration is rendered in that unit,
+	// durations of Months and longer are rendered in years
+	Seconds, Minutes, Hours, Days, Months time.Duration
+}
+
+// DefaultRelativeThresholds are the thresholds used by Relative unless WithThresholds is used
+var DefaultRelativeThresholds = RelativeThresholds{
+	JustNow: 10 * time.Second,
+	Seconds: 45 * time.Second,
+	Minutes: 45 * time.Minute,
+	Hours:   22 * time.Hour,
+	Days:    26 * DayHours * time.Hour,
+	Months:  11 * MonthDays * DayHours * time.Hour,
+}
+
+// relativeConfig defines how Relative renders *Duration
+type relativeConfig struct {
+	locale      *Locale
+	thresholds  RelativeThresholds
+	approximate bool
+	dayWords    bool
+}
+
+// RelativeOption configures Relative
+type RelativeOption func(*relativeConfig)
+
+// WithRelativeLocale renders relative phrases in the registered locale found by LookupLocale for a BCP 47 tag, English by default
+func WithRelativeLocale(tag string) RelativeOption {
+	return func(c *relativeConfig) { c.locale = LookupLocale(tag) }
+}
+
+// WithThresholds sets the thresholds that select the unit of relative phrases instead of DefaultRelativeThresholds
+func WithThresholds(thresholds RelativeThresholds) RelativeOption {
+	return func(c *relativeConfig) { c.thresholds = thresholds }
+}
+
+// WithApproximation marks rounded values with the About pattern of the locale, for example in about 3 months
+func WithApproximation() RelativeOption {
+	return func(c *relativeConfig) { c.approximate = true }
+}
+
+// WithoutDayWords renders one day as in 1 day and 1 day ago instead of tomorrow and yesterday
+func WithoutDayWords() RelativeOption {
+	return func(c *relativeConfig) { c.dayWords = false }
+}
+
+// Relative renders *Duration as a phrase relative to now: positive durations are in the future and negative ones
+// in the past. The duration is rounded to a single unit selected by the thresholds, years and months are counted
+// as YearDays and MonthDays days. One day is rendered as tomorrow or yesterday, short durations as just now
+// For example: in 3 days, 2 hours ago, yesterday or in about 3 months
+func (d *Duration) Relative(options ...RelativeOption) string {
+	c := &relativeConfig{locale: English, thresholds: DefaultRelativeThresholds, dayWords: true}
+
+	for _, o := range options {
+		o(c)
+	}
+
+	seconds := d.totalSeconds()
+	length := time.Duration(math.Abs(seconds) * float64(time.Second))
+	th := c.thresholds
+
+	if seconds == 0 || length < th.JustNow {
+		return c.locale.JustNow
+	}
+
+	// the units of relative phrases by their indexes in humanizeSeconds and thresholds, weeks are not used
+	unit := 0
+	for _, v := range []struct {
+		index     int
+		threshold time.Duration
+	}{{6, th.Seconds}, {5, th.Minutes}, {4, th.Hours}, {3, th.Days}, {1, th.Months}} {
+		if v.threshold != 0 && length < v.threshold {
+			unit = v.index
+			break
+		}
+	}
+
+	exact := math.Abs(seconds) / humanizeSeconds[unit]
+	value := math.Max(math.Round(exact), 1)
+
+	if value == 1 && unit == 3 && c.dayWords {
+		if seconds < 0 {
+			return c.locale.Yesterday
+		}
+		return c.locale.Tomorrow
+	}
+
+	names := c.locale.RelativeUnits[unit]
+	if names == nil {
+		names = c.locale.Units[unit]
+	}
+
+	str := c.locale.longName(names, strconv.FormatFloat(value, 'f', -1, 64))
+
+	if c.approximate && math.Abs(exact-value) > 1e-9 {
+		str = strings.Replace(c.locale.About, "%s", str, 1)
+	}
+
+	if seconds < 0 {
+		return strings.Replace(c.locale.Past, "%s", str, 1)
+	}
+
+	return strings.Replace(c.locale.Future, "%s", str, 1)
+}
+
+// RelativeTo renders t as a phrase relative to now using the calendar difference returned by Between
+// For example: 2 hours ago or tomorrow
+func RelativeTo(t, now time.Time, options ...RelativeOption) string {
+	return Between(now, t).Relative(options...)
+}
@@ -0,0 +1,119 @@
+package isoduration
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRelative(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		options []RelativeOption
+		result  string
+	}{
+		{
+			input:  NewDuration(0, 0, 3, 0, 0, 0, 0, false),
+			result: "in 3 days",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 2, 0, 0, true),
+			result: "2 hours ago",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 5, true),
+			result: "just now",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "just now",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 0, 0, 0, 0, true),
+			result: "yesterday",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 23, 0, 0, false),
+			result: "tomorrow",
+		},
+		{
+			input:   NewDuration(0, 0, 1, 0, 0, 0, 0, false),
+			options: []RelativeOption{WithoutDayWords()},
+			result:  "in 1 day",
+		},
+		{
+			input:   NewDuration(0, 3, 2, 0, 0, 0, 0, false),
+			options: []RelativeOption{WithApproximation()},
+			result:  "in about 3 months",
+		},
+		{
+			input:   NewDuration(0, 3, 0, 0, 0, 0, 0, true),
+			options: []RelativeOption{WithApproximation()},
+			result:  "3 months ago",
+		},
+		{
+			input:  NewDuration(1, 7, 0, 0, 0, 0, 0, true),
+			result: "2 years ago",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 30, 0, false),
+			options: []RelativeOption{WithThresholds(RelativeThresholds{Minutes: 20 * time.Minute, Hours: 22 * time.Hour})},
+			result:  "in 1 hour",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 1, 0, true),
+			options: []RelativeOption{WithRelativeLocale("ru")},
+			result:  "1 минуту назад",
+		},
+		{
+			input:   NewDuration(0, 0, 5, 0, 0, 0, 0, false),
+			options: []RelativeOption{WithRelativeLocale("ru")},
+			result:  "через 5 дней",
+		},
+		{
+			input:   NewDuration(0, 0, 3, 0, 0, 0, 0, true),
+			options: []RelativeOption{WithRelativeLocale("de")},
+			result:  "vor 3 Tagen",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 1, 0, 0, false),
+			options: []RelativeOption{WithRelativeLocale("pl")},
+			result:  "za 1 godzinę",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 3, 0, 0, true),
+			options: []RelativeOption{WithRelativeLocale("ja")},
+			result:  "3時間前",
+		},
+	}
+
+	for i, v := range tests {
+		switch r := v.input.Relative(v.options...); r {
+		case v.result:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
+
+func TestRelativeTo(t *testing.T) {
+	now := time.Date(2024, time.May, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input  time.Time
+		result string
+	}{
+		{now.Add(-2 * time.Hour), "2 hours ago"},
+		{now.AddDate(0, 0, 1), "tomorrow"},
+		{now.AddDate(0, -4, 0), "4 months ago"},
+		{now.Add(3 * time.Second), "just now"},
+	}
+
+	for i, v := range tests {
+		switch r := RelativeTo(v.input, now); r {
+		case v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
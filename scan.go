@@ -0,0 +1,210 @@
+package isoduration
+
+import (
+	"bufio"
+	"io"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Match is a duration in ISO 8601 format found in text
+type Match struct {
+	// Start and End are the byte offsets of the duration in the text, from the start of the stream for Scanner
+	Start, End int
+	// Text is the duration as it is written in the text
+	Text string
+	// Duration is the parsed duration
+	Duration *Duration
+}
+
+// isWordRune checks whether r is a part of a word, a duration must not be preceded or followed by such runes
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// matchNumber returns the length of the number at the start of s in the syntax accepted by ParseDuration:
+// an optional sign, digits and a fraction. Returns 0 if there is no number
+func matchNumber(s string) int {
+	i, digits := 0, 0
+
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+
+	for ; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+		digits++
+	}
+
+	if i < len(s) && s[i] == '.' {
+		for i++; i < len(s) && s[i] >= '0' && s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+
+	if digits == 0 {
+		return 0
+	}
+
+	return i
+}
+
+// isDesignator checks whether des is a designator of the state
+func isDesignator(state rune, des byte) bool {
+	if state == PERIOD {
+		return des == YEAR || des == MONTH || des == WEEK || des == DAY
+	}
+
+	return des == HOUR || des == MINUTE || des == SECOND
+}
+
+// matchAt returns the length of the longest duration in ISO 8601 format at the start of s, 0 if there is none.
+// The duration has the grammar of ParseDuration: an optional sign, P, numbers with period designators,
+// and T followed by numbers with time designators
+func matchAt(s string) int {
+	i := 0
+
+	if i < len(s) && (s[i] == '-' || s[i] == '+') {
+		i++
+	}
+
+	if i >= len(s) || s[i] != PERIOD {
+		return 0
+	}
+
+	i++
+	state, end := rune(PERIOD), 0
+
+	for i < len(s) {
+		if state == PERIOD && s[i] == TIME {
+			state = TIME
+			i++
+			continue
+		}
+
+		n := matchNumber(s[i:])
+		if n == 0 || i+n >= len(s) || !isDesignator(state, s[i+n]) {
+			break
+		}
+
+		i += n + 1
+		end = i
+	}
+
+	return end
+}
+
+// FindAll finds the durations in ISO 8601 format in a string, such as a log line or a document, and returns them
+// in the order of their offsets. A duration must be a whole word: words that only start like a duration are ignored,
+// as well as tokens that ParseDuration rejects
+// For example: retry after PT30S, expires P1D finds PT30S and P1D, but not PT30Sec, APT1H or P1Y1Y
+func FindAll(s string) []Match {
+	var matches []Match
+
+	for i := 0; i < len(s); {
+		if c := s[i]; c != PERIOD && c != '-' && c != '+' {
+			i++
+			continue
+		}
+
+		if prev, _ := utf8.DecodeLastRuneInString(s[:i]); i > 0 && isWordRune(prev) {
+			i++
+			continue
+		}
+
+		n := matchAt(s[i:])
+		if n == 0 {
+			i++
+			continue
+		}
+
+		if next, _ := utf8.DecodeRuneInString(s[i+n:]); i+n < len(s) && isWordRune(next) {
+			i++
+			continue
+		}
+
+		text := s[i : i+n]
+		if d, err := ParseDuration(text); err == nil {
+			matches = append(matches, Match{i, i + n, text, d})
+		}
+
+		i += n
+	}
+
+	return matches
+}
+
+// Scanner finds the durations in ISO 8601 format in a stream the way FindAll does, for example in logs or emails.
+// Successive calls to Scan step through the durations like bufio.Scanner steps through lines. The stream is read
+// in chunks that end with whitespace, so a part without whitespace must fit in the buffer, 64 KiB by default
+type Scanner struct {
+	scanner *bufio.Scanner
+	offset  int
+	matches []Match
+	match   Match
+}
+
+// NewScanner returns a new Scanner to read from r
+func NewScanner(r io.Reader) *Scanner {
+	s := &Scanner{scanner: bufio.NewScanner(r)}
+	s.scanner.Split(scanChunks)
+
+	return s
+}
+
+// scanChunks is a bufio.SplitFunc that returns the data up to and including the last whitespace byte,
+// durations do not contain whitespace, so they are never split between chunks
+func scanChunks(data []byte, atEOF bool) (int, []byte, error) {
+	if atEOF && len(data) > 0 {
+		return len(data), data, nil
+	}
+
+	for i := len(data) - 1; i >= 0; i-- {
+		switch data[i] {
+		case ' ', '\t', '\n', '\r', '\v', '\f':
+			return i + 1, data[:i+1], nil
+		}
+	}
+
+	return 0, nil, nil
+}
+
+// Buffer sets the initial buffer and the maximum size of a part of the stream without whitespace,
+// as bufio.Scanner.Buffer does. It panics if it is called after scanning has started
+func (s *Scanner) Buffer(buf []byte, max int) {
+	s.scanner.Buffer(buf, max)
+}
+
+// Scan advances the Scanner to the next duration, which will then be available through the Match method.
+// It returns false when the stream ends or an error occurs, Err returns the error
+func (s *Scanner) Scan() bool {
+	for len(s.matches) == 0 {
+		if !s.scanner.Scan() {
+			s.match = Match{}
+			return false
+		}
+
+		chunk := s.scanner.Text()
+		s.matches = FindAll(chunk)
+
+		for i := range s.matches {
+			s.matches[i].Start += s.offset
+			s.matches[i].End += s.offset
+		}
+
+		s.offset += len(chunk)
+	}
+
+	s.match, s.matches = s.matches[0], s.matches[1:]
+
+	return true
+}
+
+// Match returns the duration found by the last call to Scan
+func (s *Scanner) Match() Match {
+	return s.match
+}
+
+// Err returns the first error that was encountered by the Scanner, nil at the end of the stream
+func (s *Scanner) Err() error {
+	return s.scanner.Err()
+}
@@ -0,0 +1,93 @@
+package isoduration
+
+import (
+	"bufio"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestFindAll(t *testing.T) {
+	tests := []struct {
+		input  string
+		result []string
+		starts []int
+	}{
+		{input: "retry after PT30S, expires P1D", result: []string{"PT30S", "P1D"}, starts: []int{12, 27}},
+		{input: "-PT1H30M (-P1D) +P2W.", result: []string{"-PT1H30M", "-P1D", "+P2W"}, starts: []int{0, 10, 16}},
+		{input: "P1Y2M3W4DT5H6M7.5S", result: []string{"P1Y2M3W4DT5H6M7.5S"}, starts: []int{0}},
+		{input: "срок PT5M, ok", result: []string{"PT5M"}, starts: []int{9}},
+		{input: "P1D-PT1H", result: []string{"P1D", "PT1H"}, starts: []int{0, 4}},
+		{input: "PT30Sec APT1H PDF PT P1DT P1Y1Y pt30s P1,5D PT5 P_1D xPT1M PT1M_", result: nil, starts: nil},
+		{input: "", result: nil, starts: nil},
+	}
+
+	for i, v := range tests {
+		r := FindAll(v.input)
+
+		var texts []string
+		var starts []int
+		matched := true
+
+		for _, m := range r {
+			texts = append(texts, m.Text)
+			starts = append(starts, m.Start)
+			matched = matched && v.input[m.Start:m.End] == m.Text && m.Duration.String() == MustParseDuration(m.Text).String()
+		}
+
+		switch {
+		case matched && reflect.DeepEqual(texts, v.result) && reflect.DeepEqual(starts, v.starts):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %v %v. Result: %v %v", i, v.input, v.result, v.starts, texts, starts)
+		}
+	}
+}
+
+func TestScanner(t *testing.T) {
+	text := "2024-05-01 retry after PT30S\nexpires P1D\n\nwarn: timeout=PT0.5S reached, next -PT1M"
+	long := strings.Repeat("x", 100) + " PT1S"
+
+	tests := []struct {
+		input   string
+		buffer  int
+		result  []Match
+		isError bool
+		err     error
+	}{
+		{
+			input: text,
+			result: []Match{
+				{23, 28, "PT30S", MustParseDuration("PT30S")},
+				{37, 40, "P1D", MustParseDuration("P1D")},
+				{56, 62, "PT0.5S", MustParseDuration("PT0.5S")},
+				{77, 82, "-PT1M", MustParseDuration("-PT1M")},
+			},
+		},
+		{input: long, buffer: 16, isError: true, err: bufio.ErrTooLong},
+		{input: "no durations here"},
+	}
+
+	for i, v := range tests {
+		s := NewScanner(iotest.OneByteReader(strings.NewReader(v.input)))
+		if v.buffer > 0 {
+			s.Buffer(make([]byte, 0, v.buffer), v.buffer)
+		}
+
+		var r []Match
+		for s.Scan() {
+			r = append(r, s.Match())
+		}
+
+		switch err := s.Err(); {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && reflect.DeepEqual(r, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %v. Result: %v, %v", i, v.input, v.result, r, err)
+		}
+	}
+}
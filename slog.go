@@ -0,0 +1,63 @@
+package isoduration
+
+import (
+	"log/slog"
+	"sync/atomic"
+)
+
+// LogRepresentation defines how *Duration is represented in log/slog records
+type LogRepresentation int32
+
+const (
+	// LogGroup represents *Duration as a group of iso, nanoseconds, sign and nominal attributes
+	LogGroup LogRepresentation = iota
+	// LogISO represents *Duration as a string in ISO 8601 duration format
+	LogISO
+	// LogNanoseconds represents *Duration as total nanoseconds
+	LogNanoseconds
+	// LogTimeDuration represents *Duration as time.Duration, handlers print it in their own way
+	LogTimeDuration
+)
+
+// logRepresentation holds the representation used by LogValue
+var logRepresentation atomic.Int32
+
+// SetLogRepresentation sets the representation of *Duration in log/slog records for the whole package, LogGroup by default.
+// It is safe to call concurrently with logging
+func SetLogRepresentation(r LogRepresentation) {
+	logRepresentation.Store(int32(r))
+}
+
+// sign returns -1, 0 or 1 for negative, zero and positive *Duration
+func (d *Duration) sign() int {
+	switch s := d.totalSeconds(); {
+	case s < 0:
+		return -1
+	case s > 0:
+		return 1
+	}
+
+	return 0
+}
+
+// LogValue implements slog.LogValuer. By default *Duration is logged as a group of the ISO 8601 string, total nanoseconds,
+// sign as -1, 0 or 1 and a nominal flag set if the duration has years or months, use SetLogRepresentation to change it.
+// Nanoseconds are counted the way ToTimeDuration counts them, so nominal marks are approximated
+// For example: timeout.iso=PT1M30S timeout.nanoseconds=90000000000 timeout.sign=1 timeout.nominal=false
+func (d *Duration) LogValue() slog.Value {
+	switch LogRepresentation(logRepresentation.Load()) {
+	case LogISO:
+		return slog.StringValue(d.String())
+	case LogNanoseconds:
+		return slog.Int64Value(int64(d.ToTimeDuration()))
+	case LogTimeDuration:
+		return slog.DurationValue(d.ToTimeDuration())
+	}
+
+	return slog.GroupValue(
+		slog.String("iso", d.String()),
+		slog.Int64("nanoseconds", int64(d.ToTimeDuration())),
+		slog.Int("sign", d.sign()),
+		slog.Bool("nominal", !d.IsExact()),
+	)
+}
@@ -0,0 +1,47 @@
+package isoduration
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogValue(t *testing.T) {
+	defer SetLogRepresentation(LogGroup)
+
+	tests := []struct {
+		representation LogRepresentation
+		input          *Duration
+		result         string
+	}{
+		{LogGroup, NewDuration(0, 0, 0, 0, 0, 1, 30, false), "d.iso=PT1M30S d.nanoseconds=90000000000 d.sign=1 d.nominal=false"},
+		{LogGroup, NewDuration(0, 1, 0, 0, 0, 0, 0, true), "d.iso=-P1M d.nanoseconds=-2592000000000000 d.sign=-1 d.nominal=true"},
+		{LogGroup, NewDuration(0, 0, 0, 0, 0, 0, 0, false), "d.iso=PT0S d.nanoseconds=0 d.sign=0 d.nominal=false"},
+		{LogISO, NewDuration(0, 0, 0, 0, 0, 1, 30, false), "d=PT1M30S"},
+		{LogNanoseconds, NewDuration(0, 0, 0, 0, 0, 0, 1.5, true), "d=-1500000000"},
+		{LogTimeDuration, NewDuration(0, 0, 0, 0, 0, 1, 30, false), "d=1m30s"},
+	}
+
+	for i, v := range tests {
+		buf := bytes.Buffer{}
+		logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+			ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+				if len(groups) == 0 && a.Key != "d" {
+					return slog.Attr{}
+				}
+				return a
+			},
+		}))
+
+		SetLogRepresentation(v.representation)
+		logger.Info("", "d", v.input)
+
+		switch r := strings.TrimSpace(buf.String()); r {
+		case v.result:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, r)
+		}
+	}
+}
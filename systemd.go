@@ -0,0 +1,100 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// systemdFormat is the format name used in errors
+const systemdFormat = "systemd time span"
+
+// systemd units
+var (
+	// systemdUnits defines the units accepted by systemd time spans, see systemd.time(7)
+	systemdUnits = map[string]unitMark{
+		"usec": {"us", secondsMark, 1e-6}, "us": {"us", secondsMark, 1e-6}, "µs": {"us", secondsMark, 1e-6}, "μs": {"us", secondsMark, 1e-6},
+		"msec": {"ms", secondsMark, 1e-3}, "ms": {"ms", secondsMark, 1e-3},
+		"seconds": {"s", secondsMark, 1}, "second": {"s", secondsMark, 1}, "sec": {"s", secondsMark, 1}, "s": {"s", secondsMark, 1}, "": {"s", secondsMark, 1},
+		"minutes": {"min", minutesMark, 1}, "minute": {"min", minutesMark, 1}, "min": {"min", minutesMark, 1}, "m": {"min", minutesMark, 1},
+		"hours": {"h", hoursMark, 1}, "hour": {"h", hoursMark, 1}, "hr": {"h", hoursMark, 1}, "h": {"h", hoursMark, 1},
+		"days": {"d", daysMark, 1}, "day": {"d", daysMark, 1}, "d": {"d", daysMark, 1},
+		"weeks": {"w", weeksMark, 1}, "week": {"w", weeksMark, 1}, "w": {"w", weeksMark, 1},
+		"months": {"month", monthsMark, 1}, "month": {"month", monthsMark, 1}, "M": {"month", monthsMark, 1},
+		"years": {"y", yearsMark, 1}, "year": {"y", yearsMark, 1}, "y": {"y", yearsMark, 1},
+	}
+	// systemdSizes defines the units used to format the exact part of systemd time spans, sizes are in microseconds
+	systemdSizes = []unitSize{
+		{"w", 1e6 * 60 * 60 * DayHours * WeekDays, false},
+		{"d", 1e6 * 60 * 60 * DayHours, false},
+		{"h", 1e6 * 60 * 60, false},
+		{"min", 1e6 * 60, false},
+		{"s", 1e6, false},
+		{"ms", 1e3, false},
+		{"us", 1, false},
+	}
+)
+
+// ParseSystemd parses a systemd time span, see systemd.time(7). Units may be written in any order, separated by
+// whitespace or not, repeated units are added up and a number without a unit is in seconds. Months and years are kept
+// in their own marks, so they follow the lengths of this package rather than the systemd ones.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1h 30min, 2 weeks or 1y 12month 5.5s
+func ParseSystemd(duration string) (*Duration, error) {
+	var marks [7]float64
+	pairs, ok := scanUnits(strings.TrimSpace(duration), true)
+
+	if !ok {
+		return nil, NewIncorrectFormatError(systemdFormat, duration)
+	}
+
+	for _, p := range pairs {
+		u, ok := systemdUnits[p.unit]
+		if !ok {
+			return nil, NewIncorrectFormatError(systemdFormat, p.number+p.unit)
+		}
+
+		v, err := strconv.ParseFloat(p.number, 64)
+		if err != nil {
+			return nil, NewIncorrectFormatError(systemdFormat, p.number+p.unit)
+		}
+
+		marks[u.index] += v * u.scale
+	}
+
+	return NewDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6], false), nil
+}
+
+// FormatSystemd represents *Duration as a systemd time span the way systemd formats them, units separated by spaces.
+// Years and months are kept as they are, the rest is split into w, d, h, min, s, ms and us, rounded to microseconds.
+// Returns an error if the duration is negative
+// For example: 1y 2month 1w 1d 2h 30min
+func (d *Duration) FormatSystemd() (string, error) {
+	years, months := d.Years(), d.Months()
+	us := math.Round(d.exactSeconds() * 1e6)
+
+	switch {
+	case years < 0 || months < 0 || us < 0:
+		return "", NegativeDurationError
+	case us >= math.MaxInt64:
+		return "", DurationOverflowError
+	}
+
+	parts := make([]string, 0, 3)
+
+	if years != 0 {
+		parts = append(parts, strconv.FormatFloat(years, 'f', -1, 64)+"y")
+	}
+	if months != 0 {
+		parts = append(parts, strconv.FormatFloat(months, 'f', -1, 64)+"month")
+	}
+	if us != 0 {
+		parts = append(parts, formatUnits(int64(us), systemdSizes, " "))
+	}
+
+	if len(parts) == 0 {
+		return "0", nil
+	}
+
+	return strings.Join(parts, " "), nil
+}
@@ -0,0 +1,105 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseSystemd(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1h 30min",
+			result: NewDuration(0, 0, 0, 0, 1, 30, 0, false),
+		},
+		{
+			input:  "2 weeks",
+			result: NewDuration(0, 0, 0, 2, 0, 0, 0, false),
+		},
+		{
+			input:  "1y 12month 5.5s",
+			result: NewDuration(1, 12, 0, 0, 0, 0, 5.5, false),
+		},
+		{
+			input:  "300ms20s 5day",
+			result: NewDuration(0, 0, 5, 0, 0, 0, 20.3, false),
+		},
+		{
+			input:  "30",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 30, false),
+		},
+		{
+			input:   "1 fortnight",
+			isError: true,
+			err:     NewIncorrectFormatError(systemdFormat, "1fortnight"),
+		},
+		{
+			input:   "-1s",
+			isError: true,
+			err:     NewIncorrectFormatError(systemdFormat, "-1s"),
+		},
+		{
+			input:   "infinity",
+			isError: true,
+			err:     NewIncorrectFormatError(systemdFormat, "infinity"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseSystemd(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatSystemd(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(1, 2, 1, 1, 2, 30, 0, false),
+			result: "1y 2month 1w 1d 2h 30min",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 90, 1.5, false),
+			result: "1h 30min 1s 500ms",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "0",
+		},
+		{
+			input:   NewDuration(0, 0, 0, 0, 0, 0, 1, true),
+			isError: true,
+			err:     NegativeDurationError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatSystemd()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package isoduration
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// templateFormat is the format name used in errors
+const templateFormat = "template argument"
+
+// templateDuration converts a template argument into *Duration: *Duration, Duration, time.Duration
+// and strings in ISO 8601 duration format are accepted
+func templateDuration(v any) (*Duration, error) {
+	switch d := v.(type) {
+	case *Duration:
+		if d != nil {
+			return d, nil
+		}
+	case Duration:
+		return &d, nil
+	case time.Duration:
+		return newTimeDuration(d), nil
+	case string:
+		return ParseDuration(d)
+	}
+
+	return nil, NewIncorrectFormatError(templateFormat, fmt.Sprint(v))
+}
+
+// templateHumanizeOptions converts the options of humanizeDuration into HumanizeOption:
+// short, round, max=N, locale=TAG, separator=S and conjunction=WORD
+func templateHumanizeOptions(options []string) ([]HumanizeOption, error) {
+	result := make([]HumanizeOption, 0, len(options))
+
+	for _, option := range options {
+		key, value, _ := strings.Cut(option, "=")
+
+		switch key {
+		case "short":
+			result = append(result, WithShortUnits())
+		case "round":
+			result = append(result, WithRounding())
+		case "max":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return nil, NewIncorrectFormatError(templateFormat, option)
+			}
+			result = append(result, WithMaxUnits(n))
+		case "locale":
+			result = append(result, WithLocale(value))
+		case "separator":
+			result = append(result, WithSeparator(value))
+		case "conjunction":
+			result = append(result, WithConjunction(value))
+		default:
+			return nil, NewIncorrectFormatError(templateFormat, option)
+		}
+	}
+
+	return result, nil
+}
+
+// FuncMap returns the functions for text/template and html/template, it can be passed to Funcs of both.
+// Durations are accepted as *Duration, Duration, time.Duration or strings in ISO 8601 duration format,
+// errors stop the template execution. The functions are:
+//
+//	parseDuration "PT1H"              *Duration parsed from a string
+//	formatDuration $d                 the ISO 8601 form
+//	humanizeDuration $d "short" "max=2" "locale=ru"  the humanized form, see Humanize and its options
+//	addDuration $d .Start             the time with the duration added by AddTo, .Start | addDuration $d works too
+//	durationBetween .Start .End       the calendar difference returned by Between
+//	compareDurations $a $b            -1, 0 or 1 comparing the lengths of the durations
+//
+// For example: template.New("").Funcs(isoduration.FuncMap())
+func FuncMap() map[string]any {
+	return map[string]any{
+		"parseDuration": ParseDuration,
+		"formatDuration": func(v any) (string, error) {
+			d, err := templateDuration(v)
+			if err != nil {
+				return "", err
+			}
+
+			return d.String(), nil
+		},
+		"humanizeDuration": func(v any, options ...string) (string, error) {
+			d, err := templateDuration(v)
+			if err != nil {
+				return "", err
+			}
+
+			opts, err := templateHumanizeOptions(options)
+			if err != nil {
+				return "", err
+			}
+
+			return d.Humanize(opts...), nil
+		},
+		"addDuration": func(v any, t time.Time) (time.Time, error) {
+			d, err := templateDuration(v)
+			if err != nil {
+				return time.Time{}, err
+			}
+
+			return d.AddTo(t), nil
+		},
+		"durationBetween": Between,
+		"compareDurations": func(a, b any) (int, error) {
+			x, err := templateDuration(a)
+			if err != nil {
+				return 0, err
+			}
+
+			y, err := templateDuration(b)
+			if err != nil {
+				return 0, err
+			}
+
+			return compareDurations(x, y), nil
+		},
+	}
+}
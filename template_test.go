@@ -0,0 +1,60 @@
+package isoduration
+
+import (
+	htmltemplate "html/template"
+	"strings"
+	"testing"
+	"text/template"
+	"time"
+)
+
+func TestFuncMap(t *testing.T) {
+	start := time.Date(2024, time.January, 31, 10, 0, 0, 0, time.UTC)
+	data := map[string]any{
+		"D":     NewDuration(0, 1, 0, 0, 2, 30, 0, false),
+		"Go":    90 * time.Minute,
+		"Start": start,
+		"End":   time.Date(2024, time.March, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	tests := []struct {
+		input   string
+		result  string
+		isError bool
+	}{
+		{input: `{{ formatDuration .D }}`, result: "P1MT2H30M"},
+		{input: `{{ formatDuration .Go }}`, result: "PT1H30M"},
+		{input: `{{ (parseDuration "PT90M").Minutes }}`, result: "90"},
+		{input: `{{ humanizeDuration .D }}`, result: "1 month 2 hours 30 minutes"},
+		{input: `{{ humanizeDuration "PT2H30M" "short" }}`, result: "2h 30m"},
+		{input: `{{ humanizeDuration .D "max=2" "locale=ru" }}`, result: "1 месяц 2 часа"},
+		{input: `{{ (addDuration .D .Start).Format "2006-01-02 15:04" }}`, result: "2024-02-29 12:30"},
+		{input: `{{ (.Start | addDuration "P1D").Format "2006-01-02" }}`, result: "2024-02-01"},
+		{input: `{{ durationBetween .Start .End }}`, result: "P1M1D"},
+		{input: `{{ compareDurations .D "PT1H" }}`, result: "1"},
+		{input: `{{ if lt (compareDurations .Go "PT2H") 0 }}short{{ end }}`, result: "short"},
+		{input: `{{ formatDuration "1h" }}`, isError: true},
+		{input: `{{ humanizeDuration .D "long" }}`, isError: true},
+		{input: `{{ compareDurations .D 1 }}`, isError: true},
+	}
+
+	for i, v := range tests {
+		b := strings.Builder{}
+		err := template.Must(template.New("").Funcs(FuncMap()).Parse(v.input)).Execute(&b, data)
+
+		switch {
+		case err != nil && v.isError:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && b.String() == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s, %v", i, v.input, v.result, b.String(), err)
+		}
+	}
+
+	b := strings.Builder{}
+	html := htmltemplate.Must(htmltemplate.New("").Funcs(FuncMap()).Parse(`<b>{{ humanizeDuration .D "short" }}</b>`))
+	if err := html.Execute(&b, data); err != nil || b.String() != "<b>1mo 2h 30m</b>" {
+		t.Errorf("Test (input: html/template) failed. Result: %s, %v", b.String(), err)
+	}
+}
@@ -0,0 +1,119 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// timedeltaFormat is the format name used in errors
+const timedeltaFormat = "Python timedelta"
+
+// microseconds in a day, a Python timedelta keeps days, seconds and microseconds
+const (
+	microsPerSecond = int64(1000000)
+	microsPerDay    = microsPerSecond * 60 * 60 * DayHours
+	// timedeltaMaxDays is the limit of days of a Python timedelta
+	timedeltaMaxDays = 999999999
+)
+
+// ParseTimedelta parses the output of str(timedelta) in Python: [D day[s], ]H:MM:SS[.ffffff].
+// Only the days may be negative, the time is always added to them, so -1 day, 23:59:30 is -PT30S.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1 day, 2:03:04.500000 or -1 day, 23:59:30
+func ParseTimedelta(timedelta string) (*Duration, error) {
+	var days int64
+	clock := strings.TrimSpace(timedelta)
+
+	if before, after, ok := strings.Cut(clock, ","); ok {
+		fields := strings.Fields(before)
+		if len(fields) != 2 || (fields[1] != "day" && fields[1] != "days") {
+			return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+		}
+
+		v, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil || abs(v) > timedeltaMaxDays {
+			return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+		}
+
+		days, clock = v, strings.TrimSpace(after)
+	}
+
+	parts := strings.Split(clock, ":")
+	if len(parts) != 3 {
+		return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+	}
+
+	var micros int64
+	if s, f, ok := strings.Cut(parts[2], "."); ok {
+		if len(f) == 0 || len(f) > 6 {
+			return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+		}
+
+		v, err := strconv.ParseUint(f+strings.Repeat("0", 6-len(f)), 10, 63)
+		if err != nil {
+			return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+		}
+
+		parts[2], micros = s, int64(v)
+	}
+
+	var values [3]int64
+	for i, p := range parts {
+		v, err := strconv.ParseUint(p, 10, 63)
+		if err != nil || (i == 0 && v > 23) || (i > 0 && (v > 59 || len(p) != 2)) {
+			return nil, NewIncorrectFormatError(timedeltaFormat, timedelta)
+		}
+		values[i] = int64(v)
+	}
+
+	micros += ((values[0]*60+values[1])*60 + values[2]) * microsPerSecond
+
+	// negative days with a positive time are brought to the same sign
+	if days < 0 && micros > 0 {
+		days++
+		micros -= microsPerDay
+	}
+
+	return newDayTimeDuration(days, micros, microsPerSecond), nil
+}
+
+// FormatTimedelta represents *Duration as str(timedelta) in Python does: the days are rounded down and may be negative,
+// the time is always positive. The duration is rounded to microseconds.
+// Returns an error if the duration has nominal marks or is out of timedelta range
+// For example: 1 day, 2:03:04.500000 or -1 day, 23:59:30
+func (d *Duration) FormatTimedelta() (string, error) {
+	switch {
+	case d.period.years != 0:
+		return "", NewUnsupportedDesignatorError(timedeltaFormat, YEAR)
+	case d.period.months != 0:
+		return "", NewUnsupportedDesignatorError(timedeltaFormat, MONTH)
+	}
+
+	total := math.Round(d.exactSeconds() * float64(microsPerSecond))
+	days := math.Floor(total / float64(microsPerDay))
+
+	if math.Abs(days) > timedeltaMaxDays {
+		return "", DurationOverflowError
+	}
+
+	micros := int64(total - days*float64(microsPerDay))
+	seconds := micros / microsPerSecond
+	b := strings.Builder{}
+
+	if days != 0 {
+		b.WriteString(strconv.FormatFloat(days, 'f', -1, 64) + " day")
+		if math.Abs(days) != 1 {
+			b.WriteByte('s')
+		}
+		b.WriteString(", ")
+	}
+
+	b.WriteString(strconv.FormatInt(seconds/3600, 10) + ":" + pad2(seconds/60%60) + ":" + pad2(seconds%60))
+
+	if fraction := micros % microsPerSecond; fraction != 0 {
+		b.WriteString("." + strconv.FormatInt(fraction+microsPerSecond, 10)[1:])
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,100 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTimedelta(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1 day, 2:03:04.500000",
+			result: NewDuration(0, 0, 1, 0, 2, 3, 4.5, false),
+		},
+		{
+			input:  "-1 day, 23:59:30",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 30, true),
+		},
+		{
+			input:  "-2 days, 0:00:00",
+			result: NewDuration(0, 0, 2, 0, 0, 0, 0, true),
+		},
+		{
+			input:  "0:00:00",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:   "1 week, 0:00:00",
+			isError: true,
+			err:     NewIncorrectFormatError(timedeltaFormat, "1 week, 0:00:00"),
+		},
+		{
+			input:   "0:0:00",
+			isError: true,
+			err:     NewIncorrectFormatError(timedeltaFormat, "0:0:00"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseTimedelta(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatTimedelta(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(0, 0, 1, 0, 2, 3, 4.5, false),
+			result: "1 day, 2:03:04.500000",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 30, true),
+			result: "-1 day, 23:59:30",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 2, 0, 0, 0, false),
+			result: "14 days, 0:00:00",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			result: "0:00:00",
+		},
+		{
+			input:   NewDuration(0, 1, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(timedeltaFormat, MONTH),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatTimedelta()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
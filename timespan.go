@@ -0,0 +1,122 @@
+package isoduration
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// timeSpanFormat is the format name used in errors
+const timeSpanFormat = ".NET TimeSpan"
+
+// ticks in the time units, a .NET TimeSpan tick is 100 nanoseconds
+const (
+	ticksPerSecond = int64(10000000)
+	ticksPerDay    = ticksPerSecond * 60 * 60 * DayHours
+)
+
+// ParseTimeSpan parses a .NET TimeSpan string as TimeSpan.Parse does with the invariant culture:
+// [-]d, [-][d.]hh:mm[:ss[.fffffff]] or [-]d:hh:mm:ss[.fffffff]. The sign applies to the whole duration.
+// Returns *Duration and an error if the string could not be parsed
+// For example: 1.02:03:04.5000000, -00:00:30 or 7
+func ParseTimeSpan(timeSpan string) (*Duration, error) {
+	str := strings.TrimSpace(timeSpan)
+	isNegative := strings.HasPrefix(str, "-")
+
+	if isNegative {
+		str = str[1:]
+	}
+
+	parts := strings.Split(str, ":")
+	days, clock := "0", parts
+
+	switch {
+	case len(parts) == 1:
+		days, clock = parts[0], nil
+	case len(parts) == 4:
+		days, clock = parts[0], parts[1:]
+	case len(parts) > 4:
+		return nil, NewIncorrectFormatError(timeSpanFormat, timeSpan)
+	default:
+		if d, h, ok := strings.Cut(parts[0], "."); ok {
+			days, clock[0] = d, h
+		}
+	}
+
+	var values [4]int64
+	var fraction int64
+
+	if len(clock) > 0 {
+		if s, f, ok := strings.Cut(clock[len(clock)-1], "."); ok && len(clock) == 3 {
+			if len(f) == 0 || len(f) > 7 {
+				return nil, NewIncorrectFormatError(timeSpanFormat, timeSpan)
+			}
+
+			v, err := strconv.ParseUint(f+strings.Repeat("0", 7-len(f)), 10, 63)
+			if err != nil {
+				return nil, NewIncorrectFormatError(timeSpanFormat, timeSpan)
+			}
+
+			clock[len(clock)-1], fraction = s, int64(v)
+		}
+	}
+
+	for i, p := range append([]string{days}, clock...) {
+		v, err := strconv.ParseUint(p, 10, 63)
+		if err != nil || (i == 1 && v > 23) || (i > 1 && v > 59) {
+			return nil, NewIncorrectFormatError(timeSpanFormat, timeSpan)
+		}
+		values[i] = int64(v)
+	}
+
+	if values[0] > math.MaxInt64/ticksPerDay {
+		return nil, DurationOverflowError
+	}
+
+	ticks := ((values[1]*60+values[2])*60+values[3])*ticksPerSecond + fraction
+
+	if isNegative {
+		return newDayTimeDuration(-values[0], -ticks, ticksPerSecond), nil
+	}
+
+	return newDayTimeDuration(values[0], ticks, ticksPerSecond), nil
+}
+
+// FormatTimeSpan represents *Duration as the .NET TimeSpan constant format "c", the format of TimeSpan.ToString:
+// [-][d.]hh:mm:ss[.fffffff]. The duration is rounded to ticks of 100 nanoseconds.
+// Returns an error if the duration has nominal marks or is out of TimeSpan range
+// For example: 1.02:03:04.5000000 or -00:00:30
+func (d *Duration) FormatTimeSpan() (string, error) {
+	switch {
+	case d.period.years != 0:
+		return "", NewUnsupportedDesignatorError(timeSpanFormat, YEAR)
+	case d.period.months != 0:
+		return "", NewUnsupportedDesignatorError(timeSpanFormat, MONTH)
+	}
+
+	total := math.Round(d.exactSeconds() * float64(ticksPerSecond))
+	if math.Abs(total) >= math.MaxInt64 {
+		return "", DurationOverflowError
+	}
+
+	ticks := int64(total)
+	b := strings.Builder{}
+
+	if ticks < 0 {
+		b.WriteByte('-')
+		ticks = -ticks
+	}
+
+	if days := ticks / ticksPerDay; days != 0 {
+		b.WriteString(strconv.FormatInt(days, 10) + ".")
+	}
+
+	seconds := ticks % ticksPerDay / ticksPerSecond
+	b.WriteString(pad2(seconds/3600) + ":" + pad2(seconds/60%60) + ":" + pad2(seconds%60))
+
+	if fraction := ticks % ticksPerSecond; fraction != 0 {
+		b.WriteString("." + strconv.FormatInt(fraction+ticksPerSecond, 10)[1:])
+	}
+
+	return b.String(), nil
+}
@@ -0,0 +1,105 @@
+package isoduration
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestParseTimeSpan(t *testing.T) {
+	tests := []struct {
+		input   string
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "1.02:03:04.5000000",
+			result: NewDuration(0, 0, 1, 0, 2, 3, 4.5, false),
+		},
+		{
+			input:  "-00:00:30",
+			result: NewDuration(0, 0, 0, 0, 0, 0, 30, true),
+		},
+		{
+			input:  "7",
+			result: NewDuration(0, 0, 7, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "1:02:03:04.25",
+			result: NewDuration(0, 0, 1, 0, 2, 3, 4.25, false),
+		},
+		{
+			input:  "10:30",
+			result: NewDuration(0, 0, 0, 0, 10, 30, 0, false),
+		},
+		{
+			input:   "24:00:00",
+			isError: true,
+			err:     NewIncorrectFormatError(timeSpanFormat, "24:00:00"),
+		},
+		{
+			input:   "00:00:00.12345678",
+			isError: true,
+			err:     NewIncorrectFormatError(timeSpanFormat, "00:00:00.12345678"),
+		},
+		{
+			input:   "1.5",
+			isError: true,
+			err:     NewIncorrectFormatError(timeSpanFormat, "1.5"),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseTimeSpan(v.input)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestFormatTimeSpan(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(0, 0, 1, 0, 2, 3, 4.5, false),
+			result: "1.02:03:04.5000000",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 30, true),
+			result: "-00:00:30",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 1, 0, 90, 0, false),
+			result: "7.01:30:00",
+		},
+		{
+			input:   NewDuration(1, 0, 0, 0, 0, 0, 0, false),
+			isError: true,
+			err:     NewUnsupportedDesignatorError(timeSpanFormat, YEAR),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatTimeSpan()
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package isoduration
 
 import (
 	"math"
+	"strconv"
 	"time"
 )
 
@@ -20,10 +21,11 @@ type TimeDuration struct {
 	seconds float64
 }
 
-// Duration is basic duration structure
+// Duration is basic duration structure. The zero value has no sign and no marks, constructors and parsers
+// set the multiplier to 1 or -1
 type Duration struct {
-	period     *PeriodDuration
-	time       *TimeDuration
+	period     PeriodDuration
+	time       TimeDuration
 	multiplier float64
 }
 
@@ -36,17 +38,39 @@ func NewDuration(years, months, days, weeks, hours, minutes, seconds float64, is
 	}
 
 	return &Duration{
-		period:     &PeriodDuration{years, months, days, weeks},
-		time:       &TimeDuration{hours, minutes, seconds},
+		period:     PeriodDuration{years, months, days, weeks},
+		time:       TimeDuration{hours, minutes, seconds},
 		multiplier: multiplier,
 	}
 }
 
+// newSignedDuration creates new *Duration from signed marks, as they come from formats that keep a sign per component.
+// If every non-zero mark is negative, the sign is moved to the multiplier, otherwise the marks are kept as they are
+func newSignedDuration(years, months, days, weeks, hours, minutes, seconds float64) *Duration {
+	marks := [7]float64{years, months, days, weeks, hours, minutes, seconds}
+	isNegative := false
+
+	for _, v := range marks {
+		if v > 0 {
+			return NewDuration(years, months, days, weeks, hours, minutes, seconds, false)
+		} else if v < 0 {
+			isNegative = true
+		}
+	}
+
+	if !isNegative {
+		return NewDuration(0, 0, 0, 0, 0, 0, 0, false)
+	}
+
+	// subtraction from zero is used instead of negation so that zero marks do not turn into -0
+	return NewDuration(0-years, 0-months, 0-days, 0-weeks, 0-hours, 0-minutes, 0-seconds, true)
+}
+
 // NewFromTimeDuration creates new *Duration based on time.Duration
 // Affect: This may have some rounding inaccuracies
 func NewFromTimeDuration(t time.Duration) *Duration {
-	pd := new(PeriodDuration)
-	td := new(TimeDuration)
+	var pd PeriodDuration
+	var td TimeDuration
 	multiplier := float64(1)
 
 	if t < 0 {
@@ -94,6 +118,35 @@ func NewFromTimeDuration(t time.Duration) *Duration {
 	}
 }
 
+// newTimeDuration creates new *Duration based on time.Duration using only hours, minutes and seconds marks,
+// so the result stays exact unlike NewFromTimeDuration
+func newTimeDuration(t time.Duration) *Duration {
+	isNegative := t < 0
+
+	if isNegative {
+		t = -t
+	}
+
+	hours := t / time.Hour
+	minutes := t % time.Hour / time.Minute
+	seconds := float64(t%time.Minute) / float64(time.Second)
+
+	return NewDuration(0, 0, 0, 0, float64(hours), float64(minutes), seconds, isNegative)
+}
+
+// newDayTimeDuration creates new *Duration from whole days and the fraction of a day measured in units,
+// where perSecond is the number of units in a second. days and fraction must have the same sign
+func newDayTimeDuration(days, fraction, perSecond int64) *Duration {
+	isNegative := days < 0 || fraction < 0
+	days, fraction = abs(days), abs(fraction)
+
+	hours := fraction / (3600 * perSecond)
+	minutes := fraction / (60 * perSecond) % 60
+	seconds := float64(fraction%(60*perSecond)) / float64(perSecond)
+
+	return NewDuration(0, 0, float64(days), 0, float64(hours), float64(minutes), seconds, isNegative)
+}
+
 // Years returns years from *PeriodDuration
 func (d *Duration) Years() float64 {
 	return d.period.years * d.multiplier
@@ -129,50 +182,164 @@ func (d *Duration) Seconds() float64 {
 	return d.time.seconds * d.multiplier
 }
 
-// ToTimeDuration turns *Duration into time.Duration
-func (d *Duration) ToTimeDuration() time.Duration {
-	var timeDuration time.Duration
+// isEmpty checks whether *Duration is the zero value of Duration, which is not created by constructors and parsers,
+// for example a field that was never set
+func (d *Duration) isEmpty() bool {
+	return d.multiplier == 0
+}
+
+// IsExact checks that *Duration has no nominal marks, years and months, whose length depends on the calendar.
+// Weeks and days are considered exact and are counted as 168 and 24 hours
+func (d *Duration) IsExact() bool {
+	return d.period.years == 0 && d.period.months == 0
+}
+
+// exactSeconds returns the signed length of the exact marks of *Duration in seconds, years and months are ignored
+func (d *Duration) exactSeconds() float64 {
+	return ((((d.period.weeks*WeekDays+d.period.days)*DayHours+d.time.hours)*60+d.time.minutes)*60 + d.time.seconds) * d.multiplier
+}
+
+// totalSeconds returns the signed length of *Duration in seconds, years and months are counted as YearDays and MonthDays days
+// the way ToTimeDuration counts them
+func (d *Duration) totalSeconds() float64 {
+	return ((d.period.years*YearDays+d.period.months*MonthDays)*DayHours*3600)*d.multiplier + d.exactSeconds()
+}
+
+// compareDurations compares the lengths of a and b returned by totalSeconds, returns -1, 0 or 1
+func compareDurations(a, b *Duration) int {
+	switch x, y := a.totalSeconds(), b.totalSeconds(); {
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+
+	return 0
+}
 
-	for _, v := range periodDesignators {
-		timeDuration += periodDesignatorsDef[v].get(d.period)
+// AddTo adds *Duration to t using calendar arithmetic: years and months are added first, and the day of month
+// is clamped to the last day of the resulting month, then weeks and days are added as calendar days in the location of t,
+// and the time marks are added last as exact durations. Fractional years, months, weeks and days are carried into the time
+// using YearDays, MonthDays and DayHours
+// Affect: This may have some rounding inaccuracies for fractional years, months, weeks and days
+// For example: P1M added to 2024-01-31 gives 2024-02-29
+func (d *Duration) AddTo(t time.Time) time.Time {
+	years, yearsFrac := math.Modf(d.period.years * d.multiplier)
+	months, monthsFrac := math.Modf(d.period.months * d.multiplier)
+	days, daysFrac := math.Modf((d.period.weeks*WeekDays + d.period.days) * d.multiplier)
+
+	seconds := (yearsFrac*YearDays+monthsFrac*MonthDays+daysFrac)*DayHours*3600 +
+		(d.time.hours*3600+d.time.minutes*60+d.time.seconds)*d.multiplier
+
+	return addMonths(t, int(years*12+months)).AddDate(0, 0, int(days)).Add(time.Duration(math.Round(seconds * float64(time.Second))))
+}
+
+// addMonths adds months to t, clamping the day of month to the last day of the resulting month
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	hour, min, sec := t.Clock()
+	target := time.Date(year, month+time.Month(months), 1, hour, min, sec, t.Nanosecond(), t.Location())
+
+	// the last day of the target month is the day before the first day of the next one
+	if last := target.AddDate(0, 1, -1).Day(); day > last {
+		day = last
 	}
-	for _, v := range timeDesignators {
-		timeDuration += timeDesignatorsDef[v].get(d.time)
+
+	return target.AddDate(0, 0, day-1)
+}
+
+// Between returns the calendar difference between from and to as years, months, days, hours, minutes and seconds,
+// the result is negative if to is before from. Whole months are counted the way AddTo adds them,
+// and days are calendar days in the location of from, so for to after from AddTo of the result to from gives to
+// For example: between 2024-01-31 and 2024-03-01 is P1M1D
+func Between(from, to time.Time) *Duration {
+	isNegative := to.Before(from)
+
+	if isNegative {
+		from, to = to, from
 	}
 
+	months := (to.Year()-from.Year())*12 + int(to.Month()-from.Month())
+	if addMonths(from, months).After(to) {
+		months--
+	}
+
+	start := addMonths(from, months)
+	days := int(to.Sub(start) / (DayHours * time.Hour))
+
+	// calendar days may be shorter or longer than 24 hours because of daylight saving time
+	for days > 0 && start.AddDate(0, 0, days).After(to) {
+		days--
+	}
+	for !start.AddDate(0, 0, days+1).After(to) {
+		days++
+	}
+
+	rest := newTimeDuration(to.Sub(start.AddDate(0, 0, days)))
+
+	return NewDuration(float64(months/12), float64(months%12), float64(days), 0, rest.time.hours, rest.time.minutes, rest.time.seconds, isNegative)
+}
+
+// ToTimeDuration turns *Duration into time.Duration, years and months are counted as YearDays and MonthDays days.
+// Every mark is converted separately, so fractions of a nanosecond are truncated per mark
+func (d *Duration) ToTimeDuration() time.Duration {
+	timeDuration := time.Duration(float64(time.Hour)*DayHours*YearDays*d.period.years) +
+		time.Duration(float64(time.Hour)*DayHours*MonthDays*d.period.months) +
+		time.Duration(float64(time.Hour*DayHours*WeekDays)*d.period.weeks) +
+		time.Duration(float64(time.Hour)*DayHours*d.period.days) +
+		time.Duration(float64(time.Hour)*d.time.hours) +
+		time.Duration(float64(time.Minute)*d.time.minutes) +
+		time.Duration(float64(time.Second)*d.time.seconds)
+
 	return timeDuration * time.Duration(d.multiplier)
 }
 
-// ToTimeDuration turns *Duration into a string in ISO 8601 duration format
-func (d *Duration) String() string {
-	prefix := "P"
-	period := ""
-	tm := ""
+// formatDesignators are the designators of the marks in the order of AppendFormat, time designators start at index 4
+var formatDesignators = [7]byte{YEAR, MONTH, WEEK, DAY, HOUR, MINUTE, SECOND}
+
+// AppendFormat appends *Duration in ISO 8601 duration format, as String returns it, to dst and returns the extended buffer.
+// Zero marks are omitted, a duration without marks is PT0S. It does not allocate if dst has enough capacity
+// For example: P1Y2M3W4DT5H6M7.5S or -PT1H30M
+func (d *Duration) AppendFormat(dst []byte) []byte {
+	marks := [7]float64{d.period.years, d.period.months, d.period.weeks, d.period.days, d.time.hours, d.time.minutes, d.time.seconds}
+	period, tm := false, false
 
-	for _, v := range periodDesignators {
-		if tmp := periodDesignatorsDef[v].string(d.period); tmp != "0"+string(v) {
-			period += tmp
+	// a mark is omitted only if it is formatted as 0, -0 is formatted as it is
+	for i, v := range marks {
+		if v != 0 || math.Signbit(v) {
+			period, tm = period || i < 4, tm || i >= 4
 		}
 	}
 
-	for _, v := range timeDesignators {
-		if tmp := timeDesignatorsDef[v].string(d.time); tmp != "0"+string(v) {
-			tm += tmp
-		}
+	if !period && !tm {
+		return append(dst, "PT0S"...)
 	}
 
-	if d.multiplier == -1 && tm != "" && period != "" {
-		prefix = "-" + prefix
+	if d.multiplier == -1 {
+		dst = append(dst, '-')
 	}
+	dst = append(dst, PERIOD)
 
-	switch {
-	case tm == "" && period == "":
-		tm = "T0S"
-	case tm != "":
-		tm = "T" + tm
+	for i, v := range marks {
+		if i == 4 && tm {
+			dst = append(dst, TIME)
+		}
+
+		if v != 0 || math.Signbit(v) {
+			dst = append(strconv.AppendFloat(dst, v, 'f', -1, 64), formatDesignators[i])
+		}
 	}
 
-	return prefix + period + tm
+	return dst
+}
+
+// String represents *Duration as a string in ISO 8601 duration format, it allocates only the result
+// unless the string is longer than 64 bytes
+// For example: P1Y2M3W4DT5H6M7.5S or -PT1H30M
+func (d *Duration) String() string {
+	var buffer [64]byte
+
+	return string(d.AppendFormat(buffer[:0]))
 }
 
 // FormatTimeDuration represents time.Duration as a string in ISO 8601 duration format
@@ -203,7 +370,10 @@ func (d *Duration) UnmarshalJSON(source []byte) error {
 
 // MarshalJSON designed to deserialize *Duration to a string in ISO 8601 duration format, defined in user code via the json library
 func (d Duration) MarshalJSON() ([]byte, error) {
-	return []byte("\"" + d.String() + "\""), nil
+	buffer := make([]byte, 0, 32)
+	buffer = d.AppendFormat(append(buffer, '"'))
+
+	return append(buffer, '"'), nil
 }
 
 // UnmarshalYAML designed to serialize a string in ISO 8601 duration format to *Duration, defined in user code via the gopkg.in/yaml.v3 library
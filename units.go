@@ -0,0 +1,143 @@
+package isoduration
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// unitMark describes a unit of a compact duration syntax like 1h30m
+type unitMark struct {
+	unit  string
+	index int
+	scale float64
+}
+
+// marks indexes, the order of NewDuration arguments
+const (
+	yearsMark = iota
+	monthsMark
+	daysMark
+	weeksMark
+	hoursMark
+	minutesMark
+	secondsMark
+)
+
+// unitPair is a number and its unit found in a compact duration syntax
+type unitPair struct {
+	number string
+	unit   string
+}
+
+// scanUnits splits a compact duration into number and unit pairs.
+// If spaces is set, whitespace between pairs and between a number and its unit is allowed.
+// A number without a unit is returned with an empty unit, returns false if the string has other tokens
+func scanUnits(duration string, spaces bool) ([]unitPair, bool) {
+	var pairs []unitPair
+	runes := []rune(duration)
+	i := 0
+
+	skip := func() {
+		for spaces && i < len(runes) && unicode.IsSpace(runes[i]) {
+			i++
+		}
+	}
+
+	for skip(); i < len(runes); skip() {
+		start := i
+		for i < len(runes) && (runes[i] >= '0' && runes[i] <= '9' || runes[i] == '.') {
+			i++
+		}
+
+		number := string(runes[start:i])
+		if number == "" {
+			return nil, false
+		}
+
+		skip()
+
+		start = i
+		for i < len(runes) && unicode.IsLetter(runes[i]) {
+			i++
+		}
+
+		pairs = append(pairs, unitPair{number, string(runes[start:i])})
+	}
+
+	return pairs, len(pairs) > 0
+}
+
+// cutSign removes a leading sign from a duration and reports whether it was negative
+func cutSign(duration string) (string, bool) {
+	switch {
+	case strings.HasPrefix(duration, "-"):
+		return duration[1:], true
+	case strings.HasPrefix(duration, "+"):
+		return duration[1:], false
+	}
+
+	return duration, false
+}
+
+// parseOrderedUnits parses a compact duration of integer values whose units must follow the order of units,
+// each unit at most once, with an optional leading sign. If fold is set, units are case-insensitive
+func parseOrderedUnits(duration, format string, units []unitMark, fold bool) (*Duration, error) {
+	var marks [7]float64
+	str, isNegative := cutSign(duration)
+	pairs, ok := scanUnits(str, false)
+
+	if !ok {
+		return nil, NewIncorrectFormatError(format, duration)
+	}
+
+	next := 0
+	for _, p := range pairs {
+		pos := next
+		for pos < len(units) && !(units[pos].unit == p.unit || (fold && strings.EqualFold(units[pos].unit, p.unit))) {
+			pos++
+		}
+
+		if pos == len(units) {
+			return nil, NewIncorrectFormatError(format, p.number+p.unit)
+		}
+
+		v, err := strconv.ParseUint(p.number, 10, 63)
+		if err != nil {
+			return nil, NewIncorrectFormatError(format, p.number+p.unit)
+		}
+
+		marks[units[pos].index] += float64(v) * units[pos].scale
+		next = pos + 1
+	}
+
+	return NewDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6], isNegative), nil
+}
+
+// unitSize describes a unit used to format a compact duration syntax, size is in the smallest unit of the syntax.
+// If exact is set, the unit is used only if it divides the value without a remainder
+type unitSize struct {
+	unit  string
+	size  int64
+	exact bool
+}
+
+// formatUnits greedily splits the non-negative value into the units, skipping zero units, and joins them with sep
+func formatUnits(value int64, units []unitSize, sep string) string {
+	b := strings.Builder{}
+
+	for _, u := range units {
+		if value < u.size || (u.exact && value%u.size != 0) {
+			continue
+		}
+
+		if b.Len() != 0 {
+			b.WriteString(sep)
+		}
+
+		b.WriteString(strconv.FormatInt(value/u.size, 10) + u.unit)
+		value %= u.size
+	}
+
+	return b.String()
+}
@@ -0,0 +1,146 @@
+package isoduration
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+// Rule checks *Duration, returns an error if the duration does not pass the check
+type Rule func(d *Duration) error
+
+// Range checks that the duration is not less than min and not greater than max, a nil bound is not checked.
+// Durations are compared by their length with years and months counted as YearDays and MonthDays days
+// For example: Range(NewDuration(0, 0, 0, 0, 0, 0, 1, false), NewDuration(0, 0, 0, 0, 1, 0, 0, false)) for PT1S to PT1H
+func Range(min, max *Duration) Rule {
+	return func(d *Duration) error {
+		switch {
+		case min != nil && compareDurations(d, min) < 0:
+			return NewOutOfRangeError(d.String(), min.String(), false)
+		case max != nil && compareDurations(d, max) > 0:
+			return NewOutOfRangeError(d.String(), max.String(), true)
+		}
+
+		return nil
+	}
+}
+
+// NonNegative checks that the duration is not negative, so a duration with mixed signs is checked by its length
+func NonNegative() Rule {
+	return func(d *Duration) error {
+		if d.totalSeconds() < 0 {
+			return NegativeDurationError
+		}
+
+		return nil
+	}
+}
+
+// ExactOnly checks that the duration has no years and months, whose length depends on the calendar
+func ExactOnly() Rule {
+	return func(d *Duration) error {
+		if !d.IsExact() {
+			return NominalDurationError
+		}
+
+		return nil
+	}
+}
+
+// MaxPrecision checks that the length of the duration is a multiple of precision, years and months are counted
+// as YearDays and MonthDays days. A precision that is not positive is not checked
+// For example: MaxPrecision(time.Millisecond) rejects PT0.0001S
+func MaxPrecision(precision time.Duration) Rule {
+	return func(d *Duration) error {
+		if precision <= 0 {
+			return nil
+		}
+
+		v := d.totalSeconds() * float64(time.Second) / float64(precision)
+		if math.Abs(v-math.Round(v)) > 1e-6 {
+			return NewPrecisionError(d.String(), NewFromTimeDuration(precision).String())
+		}
+
+		return nil
+	}
+}
+
+// Validate checks *Duration with the rules, returns the errors of all failed rules joined
+func (d *Duration) Validate(rules ...Rule) error {
+	var errs []error
+
+	for _, rule := range rules {
+		if err := rule(d); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// tagRules returns the rules of the isoduration struct tag options in the order they are listed in Validate
+func tagRules(tag string, options map[string]string) ([]Rule, error) {
+	var rules []Rule
+
+	bounds, err := tagBounds(tag, options)
+	if err != nil {
+		return nil, err
+	}
+	if bounds != nil {
+		rules = append(rules, bounds)
+	}
+	if _, ok := options["nonnegative"]; ok {
+		rules = append(rules, NonNegative())
+	}
+	if _, ok := options["exact"]; ok {
+		rules = append(rules, ExactOnly())
+	}
+	if value, ok := options["precision"]; ok {
+		precision, err := ParseDuration(value)
+		if err != nil {
+			return nil, NewIncorrectTagError(tag)
+		}
+		rules = append(rules, MaxPrecision(precision.ToTimeDuration()))
+	}
+
+	return rules, nil
+}
+
+// Validate checks the Duration and *Duration fields of the struct pointed to by v with the rules of the isoduration struct tag.
+// The tag options are:
+//
+//	min=PT1S          the minimum value
+//	max=PT1H          the maximum value
+//	nonnegative       the value must not be negative
+//	exact             the value must not have years and months
+//	precision=PT0.001S the value must be a multiple of the precision
+//	required          the value must be set, a nil *Duration or a zero Duration value fails
+//
+// Unset values pass all checks but required. Nested structs and non-nil pointers to structs are validated too,
+// as are embedded structs of unexported types, a pointer met again in a cyclic struct is validated once.
+// Returns the errors of all fields joined, each is a *FieldError with the path of the field wrapping the check errors
+// For example: Timeout *isoduration.Duration `isoduration:"min=PT1S,max=PT1H,nonnegative,exact"`
+func Validate(v any) error {
+	return walkFields(v, "isoduration", func(d *Duration, tag string) error {
+		options, err := parseTag(tag, "min", "max", "nonnegative", "exact", "precision", "required")
+		if err != nil {
+			return err
+		}
+
+		rules, err := tagRules(tag, options)
+		if err != nil {
+			return err
+		}
+
+		_, isRequired := options["required"]
+
+		switch {
+		case d.isEmpty() && isRequired:
+			return RequiredDurationError
+		case d.isEmpty():
+			return nil
+		}
+
+		return d.Validate(rules...)
+	})
+}
@@ -0,0 +1,127 @@
+package isoduration
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestValidateRules(t *testing.T) {
+	second := NewDuration(0, 0, 0, 0, 0, 0, 1, false)
+	hour := NewDuration(0, 0, 0, 0, 1, 0, 0, false)
+
+	tests := []struct {
+		input   *Duration
+		rules   []Rule
+		isError bool
+		err     error
+	}{
+		{input: NewDuration(0, 0, 0, 0, 0, 30, 0, false), rules: []Rule{Range(second, hour), NonNegative(), ExactOnly()}},
+		{input: NewDuration(0, 0, 0, 0, 0, 0, 0.5, false), rules: []Rule{Range(second, hour)}, isError: true, err: NewOutOfRangeError("PT0.5S", "PT1S", false)},
+		{input: NewDuration(0, 0, 1, 0, 0, 0, 0, false), rules: []Rule{Range(nil, hour)}, isError: true, err: NewOutOfRangeError("P1D", "PT1H", true)},
+		{input: NewDuration(0, 0, 1, 0, 0, 0, 0, false), rules: []Rule{Range(second, nil)}},
+		{input: NewDuration(0, 0, 0, 0, 0, 0, 1, true), rules: []Rule{NonNegative()}, isError: true, err: NegativeDurationError},
+		{input: NewDuration(0, 1, 0, 0, 0, 0, 0, false), rules: []Rule{ExactOnly()}, isError: true, err: NominalDurationError},
+		{input: NewDuration(0, 0, 0, 0, 0, 0, 0.0001, false), rules: []Rule{MaxPrecision(time.Millisecond)}, isError: true, err: NewPrecisionError("PT0.0001S", "PT0.001S")},
+		{input: NewDuration(0, 0, 0, 0, 1, 0, 0.25, false), rules: []Rule{MaxPrecision(time.Millisecond)}},
+		{input: NewDuration(0, 0, 0, 0, 0, 0, 2, true), rules: []Rule{NonNegative(), Range(second, nil)}, isError: true, err: NegativeDurationError},
+	}
+
+	for i, v := range tests {
+		switch err := v.input.Validate(v.rules...); {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError:
+			t.Logf("Test %d (iso duration: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %v. Result: %v", i, v.input, v.err, err)
+		}
+	}
+}
+
+type validateConfig struct {
+	Timeout *Duration `isoduration:"min=PT1S,max=PT1H,nonnegative"`
+	Period  Duration  `isoduration:"exact,precision=PT0.001S"`
+	Retry   *Duration `isoduration:"required"`
+	Nested  struct {
+		Idle *Duration `isoduration:"max=PT10M"`
+	}
+}
+
+type validateBase struct {
+	Idle *Duration `isoduration:"max=PT10M"`
+}
+
+type validateNode struct {
+	Wait Duration `isoduration:"nonnegative"`
+	Next *validateNode
+}
+
+type validateEmbedded struct {
+	validateBase
+	Node *validateNode
+}
+
+func TestValidate(t *testing.T) {
+	valid := validateConfig{
+		Timeout: NewDuration(0, 0, 0, 0, 0, 30, 0, false),
+		Period:  *NewDuration(0, 0, 1, 0, 0, 0, 0.5, false),
+		Retry:   NewDuration(0, 0, 0, 0, 0, 0, 5, false),
+	}
+
+	invalid := validateConfig{
+		Timeout: NewDuration(0, 0, 0, 0, 2, 0, 0, false),
+		Period:  *NewDuration(0, 1, 0, 0, 0, 0, 0.0001, false),
+	}
+	invalid.Nested.Idle = NewDuration(0, 0, 0, 0, 1, 0, 0, false)
+
+	if err := Validate(&valid); err != nil {
+		t.Errorf("Test (input: valid) failed. Expected: nil. Result: %v", err)
+	}
+
+	err := Validate(&invalid)
+	for i, e := range []error{
+		NewFieldError("Timeout", NewOutOfRangeError("PT2H", "PT1H", true)),
+		NewFieldError("Period", NominalDurationError),
+		NewFieldError("Period", NewPrecisionError("P1MT0.0001S", "PT0.001S")),
+		NewFieldError("Retry", RequiredDurationError),
+		NewFieldError("Nested.Idle", NewOutOfRangeError("PT1H", "PT10M", true)),
+	} {
+		switch {
+		case errors.Is(err, e):
+			t.Logf("Test %d (input: %v) completed successfully", i, e)
+		default:
+			t.Errorf("Test %d (input: %v) failed. Result: %v", i, e, err)
+		}
+	}
+
+	if err := Validate(&struct {
+		D Duration `isoduration:"min=1s"`
+	}{}); !errors.Is(err, NewFieldError("D", NewIncorrectTagError("min=1s"))) {
+		t.Errorf("Test (input: min=1s) failed. Expected: incorrect tag. Result: %v", err)
+	}
+}
+
+func TestValidateEmbedded(t *testing.T) {
+	node := &validateNode{Wait: *NewDuration(0, 0, 0, 0, 0, 0, 1, true)}
+	node.Next = node
+	config := validateEmbedded{validateBase{NewDuration(0, 0, 0, 0, 1, 0, 0, false)}, node}
+
+	err := Validate(&config)
+
+	for i, e := range []error{
+		NewFieldError("validateBase.Idle", NewOutOfRangeError("PT1H", "PT10M", true)),
+		NewFieldError("Node.Wait", NegativeDurationError),
+	} {
+		switch {
+		case errors.Is(err, e):
+			t.Logf("Test %d (input: %v) completed successfully", i, e)
+		default:
+			t.Errorf("Test %d (input: %v) failed. Result: %v", i, e, err)
+		}
+	}
+
+	if n := len(err.(interface{ Unwrap() []error }).Unwrap()); n != 2 {
+		t.Errorf("Test (input: cyclic struct) failed. Expected: 2 errors. Result: %d, %v", n, err)
+	}
+}
@@ -0,0 +1,274 @@
+package isoduration
+
+import (
+	"encoding/xml"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// XSDType defines the XML Schema 1.1 duration datatypes
+type XSDType int
+
+const (
+	// XSDDuration is the xs:duration datatype
+	XSDDuration XSDType = iota
+	// XSDDayTimeDuration is the xs:dayTimeDuration datatype, it has no years and months
+	XSDDayTimeDuration
+	// XSDYearMonthDuration is the xs:yearMonthDuration datatype, it has only years and months
+	XSDYearMonthDuration
+)
+
+// format returns the datatype name used in errors
+func (x XSDType) format() string {
+	switch x {
+	case XSDDayTimeDuration:
+		return "XML Schema dayTime"
+	case XSDYearMonthDuration:
+		return "XML Schema yearMonth"
+	default:
+		return "XML Schema"
+	}
+}
+
+// xsdValue returns *Duration as the XML Schema value space: a number of months and a number of seconds
+func (d *Duration) xsdValue() (months, seconds float64) {
+	months = (d.period.years*12 + d.period.months) * d.multiplier
+	seconds = ((((d.period.weeks*WeekDays+d.period.days)*DayHours+d.time.hours)*60+d.time.minutes)*60 + d.time.seconds) * d.multiplier
+
+	return months, seconds
+}
+
+// ValidateXSD checks that *Duration can be represented as the given XML Schema datatype.
+// Months must be whole after years are converted into months, and months and seconds must have the same sign
+func (d *Duration) ValidateXSD(kind XSDType) error {
+	months, seconds := d.xsdValue()
+
+	switch {
+	case months != math.Trunc(months):
+		return NewUnsupportedDesignatorError(kind.format(), MONTH)
+	case (months < 0 && seconds > 0) || (months > 0 && seconds < 0):
+		return MixedSignsError
+	case kind == XSDDayTimeDuration && d.period.years != 0:
+		return NewUnsupportedDesignatorError(kind.format(), YEAR)
+	case kind == XSDDayTimeDuration && d.period.months != 0:
+		return NewUnsupportedDesignatorError(kind.format(), MONTH)
+	case kind == XSDYearMonthDuration && seconds != 0:
+		for _, v := range [5]struct {
+			value      float64
+			designator rune
+		}{{d.period.weeks, WEEK}, {d.period.days, DAY}, {d.time.hours, HOUR}, {d.time.minutes, MINUTE}, {d.time.seconds, SECOND}} {
+			if v.value != 0 {
+				return NewUnsupportedDesignatorError(kind.format(), v.designator)
+			}
+		}
+	}
+
+	return nil
+}
+
+// FormatXSD represents *Duration in the canonical form of the given XML Schema datatype.
+// Months are normalized into years and months, seconds into days, hours, minutes and seconds,
+// and weeks are represented as days. Returns an error if the duration cannot be represented
+// For example: P1Y14M10D becomes P2Y2M10D and PT36H becomes P1DT12H
+func (d *Duration) FormatXSD(kind XSDType) (string, error) {
+	if err := d.ValidateXSD(kind); err != nil {
+		return "", err
+	}
+
+	months, seconds := d.xsdValue()
+	b := strings.Builder{}
+
+	if months < 0 || seconds < 0 {
+		b.WriteByte('-')
+		months, seconds = math.Abs(months), math.Abs(seconds)
+	}
+
+	b.WriteRune(PERIOD)
+
+	if months == 0 && seconds == 0 {
+		if kind == XSDYearMonthDuration {
+			return "P0M", nil
+		}
+		return "PT0S", nil
+	}
+
+	if months != 0 {
+		y, m := int64(months)/12, int64(months)%12
+
+		if y != 0 {
+			b.WriteString(strconv.FormatInt(y, 10))
+			b.WriteRune(YEAR)
+		}
+		if m != 0 {
+			b.WriteString(strconv.FormatInt(m, 10))
+			b.WriteRune(MONTH)
+		}
+	}
+
+	if seconds != 0 {
+		whole := math.Floor(seconds)
+		nanos := int64(math.Round((seconds - whole) * 1e9))
+
+		if nanos == 1e9 {
+			whole++
+			nanos = 0
+		}
+
+		ss := int64(whole)
+		day, hour, min, sec := ss/86400, ss%86400/3600, ss%3600/60, ss%60
+
+		if day != 0 {
+			b.WriteString(strconv.FormatInt(day, 10))
+			b.WriteRune(DAY)
+		}
+
+		if hour != 0 || min != 0 || sec != 0 || nanos != 0 {
+			b.WriteRune(TIME)
+
+			if hour != 0 {
+				b.WriteString(strconv.FormatInt(hour, 10))
+				b.WriteRune(HOUR)
+			}
+			if min != 0 {
+				b.WriteString(strconv.FormatInt(min, 10))
+				b.WriteRune(MINUTE)
+			}
+			if sec != 0 || nanos != 0 {
+				b.WriteString(strconv.FormatInt(sec, 10) + formatFraction(nanos, 1e9))
+				b.WriteRune(SECOND)
+			}
+		}
+	}
+
+	return b.String(), nil
+}
+
+// ParseXSDDuration parses a string in the lexical form of the given XML Schema datatype.
+// Unlike ParseDuration, weeks, fractions other than seconds and the + sign are not allowed.
+// dayTimeDuration forbids the Y and M period designators and yearMonthDuration the D and time designators, even with zero values.
+// Returns *Duration and an error if the string could not be parsed
+// For example: P1Y2M3DT4H5M6.5S or -PT30M
+func ParseXSDDuration(duration string, kind XSDType) (*Duration, error) {
+	var marks [7]float64
+	in := duration
+	multiplier := float64(1)
+
+	if strings.HasPrefix(duration, "-") {
+		multiplier = -1
+		duration = duration[1:]
+	}
+
+	if len(duration) < 2 || duration[0] != PERIOD || duration[len(duration)-1] == TIME {
+		return nil, NewIncorrectFormatError(kind.format(), in)
+	}
+
+	// designators in their required order, period designators first
+	order := [6]rune{YEAR, MONTH, DAY, HOUR, MINUTE, SECOND}
+	indexes := [6]int{0, 1, 2, 4, 5, 6}
+	next := 0
+	start := 1
+	inTime := false
+
+	for i := 1; i < len(duration); i++ {
+		char := rune(duration[i])
+
+		switch {
+		case char >= '0' && char <= '9':
+			continue
+		case char == '.':
+			continue
+		case char == TIME && start == i && !inTime:
+			inTime = true
+			next = 3
+			start = i + 1
+			continue
+		}
+
+		pos := next
+		for pos < len(order) && order[pos] != char {
+			pos++
+		}
+
+		// a time designator must follow T and a period designator must not
+		if pos == len(order) || (pos >= 3) != inTime {
+			return nil, NewIncorrectFormatError(kind.format(), in)
+		}
+
+		// the subtypes forbid designators by their lexical form whatever their values are, as in P0Y1D for dayTimeDuration
+		if (kind == XSDDayTimeDuration && pos < 2) || (kind == XSDYearMonthDuration && pos >= 2) {
+			return nil, NewUnsupportedDesignatorError(kind.format(), char)
+		}
+
+		nums := duration[start:i]
+		if nums == "" || nums[0] == '.' || nums[len(nums)-1] == '.' || (char != SECOND && strings.ContainsRune(nums, '.')) {
+			return nil, NewIncorrectFormatError(kind.format(), in)
+		}
+
+		v, err := strconv.ParseFloat(nums, 64)
+		if err != nil {
+			return nil, NewIncorrectFormatError(kind.format(), in)
+		}
+
+		marks[indexes[pos]] = v
+		next = pos + 1
+		start = i + 1
+	}
+
+	if start != len(duration) {
+		return nil, NewIncorrectFormatError(kind.format(), in)
+	}
+
+	d := NewDuration(marks[0], marks[1], marks[2], marks[3], marks[4], marks[5], marks[6], multiplier == -1)
+
+	if err := d.ValidateXSD(kind); err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// MarshalXML designed to deserialize *Duration to the canonical xs:duration form, defined in user code via the encoding/xml library
+func (d Duration) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	str, err := d.FormatXSD(XSDDuration)
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeElement(str, start)
+}
+
+// UnmarshalXML designed to serialize a string in the xs:duration lexical form to *Duration, defined in user code via the encoding/xml library
+func (d *Duration) UnmarshalXML(dec *xml.Decoder, start xml.StartElement) error {
+	var str string
+	if err := dec.DecodeElement(&str, &start); err != nil {
+		return err
+	}
+
+	if parsed, err := ParseXSDDuration(strings.TrimSpace(str), XSDDuration); err == nil {
+		*d = *parsed
+		return nil
+	} else {
+		return err
+	}
+}
+
+// MarshalXMLAttr designed to deserialize *Duration to the canonical xs:duration form in an attribute, defined in user code via the encoding/xml library
+func (d Duration) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	str, err := d.FormatXSD(XSDDuration)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: str}, nil
+}
+
+// UnmarshalXMLAttr designed to serialize an attribute in the xs:duration lexical form to *Duration, defined in user code via the encoding/xml library
+func (d *Duration) UnmarshalXMLAttr(attr xml.Attr) error {
+	if parsed, err := ParseXSDDuration(strings.TrimSpace(attr.Value), XSDDuration); err == nil {
+		*d = *parsed
+		return nil
+	} else {
+		return err
+	}
+}
@@ -0,0 +1,270 @@
+package isoduration
+
+import (
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type X struct {
+	XMLName xml.Name  `xml:"x"`
+	A       *Duration `xml:"a,attr"`
+	D       *Duration `xml:"d"`
+}
+
+func TestFormatXSD(t *testing.T) {
+	tests := []struct {
+		input   *Duration
+		kind    XSDType
+		result  string
+		isError bool
+		err     error
+	}{
+		{
+			input:  NewDuration(1, 14, 10, 0, 0, 0, 0, false),
+			kind:   XSDDuration,
+			result: "P2Y2M10D",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 36, 0, 0, false),
+			kind:   XSDDuration,
+			result: "P1DT12H",
+		},
+		{
+			input:  NewDuration(0, 0, 1, 1, 0, 90, 6.5, true),
+			kind:   XSDDayTimeDuration,
+			result: "-P8DT1H30M6.5S",
+		},
+		{
+			input:  NewDuration(1.5, 0, 0, 0, 0, 0, 0, false),
+			kind:   XSDYearMonthDuration,
+			result: "P1Y6M",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+			kind:   XSDYearMonthDuration,
+			result: "P0M",
+		},
+		{
+			input:  NewDuration(0, 0, 0, 0, 0, 0, 0, true),
+			kind:   XSDDuration,
+			result: "PT0S",
+		},
+		{
+			input:   NewDuration(0, 1.5, 0, 0, 0, 0, 0, false),
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDDuration.format(), MONTH),
+		},
+		{
+			input:   NewDuration(1, 0, 0, 0, 0, 0, 0, false),
+			kind:    XSDDayTimeDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDDayTimeDuration.format(), YEAR),
+		},
+		{
+			input:   NewDuration(1, 0, 0, 0, 1, 0, 0, false),
+			kind:    XSDYearMonthDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDYearMonthDuration.format(), HOUR),
+		},
+		{
+			input:   NewDuration(-1, 0, 1, 0, 0, 0, 0, false),
+			kind:    XSDDuration,
+			isError: true,
+			err:     MixedSignsError,
+		},
+	}
+
+	for i, v := range tests {
+		result, err := v.input.FormatXSD(v.kind)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && !v.isError && result == v.result:
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestParseXSDDuration(t *testing.T) {
+	tests := []struct {
+		input   string
+		kind    XSDType
+		result  *Duration
+		isError bool
+		err     error
+	}{
+		{
+			input:  "P1Y2M3DT4H5M6.5S",
+			kind:   XSDDuration,
+			result: NewDuration(1, 2, 3, 0, 4, 5, 6.5, false),
+		},
+		{
+			input:  "-PT30M",
+			kind:   XSDDayTimeDuration,
+			result: NewDuration(0, 0, 0, 0, 0, 30, 0, true),
+		},
+		{
+			input:  "P14M",
+			kind:   XSDYearMonthDuration,
+			result: NewDuration(0, 14, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:   "P1W",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "P1W"),
+		},
+		{
+			input:   "+P1D",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "+P1D"),
+		},
+		{
+			input:   "P1.5D",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "P1.5D"),
+		},
+		{
+			input:   "P1DT",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "P1DT"),
+		},
+		{
+			input:   "P1DH",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "P1DH"),
+		},
+		{
+			input:   "PT1M1H",
+			kind:    XSDDuration,
+			isError: true,
+			err:     NewIncorrectFormatError(XSDDuration.format(), "PT1M1H"),
+		},
+		{
+			input:   "P1M",
+			kind:    XSDDayTimeDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDDayTimeDuration.format(), MONTH),
+		},
+		{
+			input:   "P1MT1S",
+			kind:    XSDYearMonthDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDYearMonthDuration.format(), SECOND),
+		},
+		{
+			input:   "P0Y1D",
+			kind:    XSDDayTimeDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDDayTimeDuration.format(), YEAR),
+		},
+		{
+			input:   "P0M",
+			kind:    XSDDayTimeDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDDayTimeDuration.format(), MONTH),
+		},
+		{
+			input:   "P1YT0S",
+			kind:    XSDYearMonthDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDYearMonthDuration.format(), SECOND),
+		},
+		{
+			input:   "PT0S",
+			kind:    XSDYearMonthDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDYearMonthDuration.format(), SECOND),
+		},
+		{
+			input:   "P0D",
+			kind:    XSDYearMonthDuration,
+			isError: true,
+			err:     NewUnsupportedDesignatorError(XSDYearMonthDuration.format(), DAY),
+		},
+		{
+			input:  "PT0S",
+			kind:   XSDDayTimeDuration,
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+		{
+			input:  "P0M",
+			kind:   XSDYearMonthDuration,
+			result: NewDuration(0, 0, 0, 0, 0, 0, 0, false),
+		},
+	}
+
+	for i, v := range tests {
+		result, err := ParseXSDDuration(v.input, v.kind)
+
+		switch {
+		case err != nil && v.isError && errors.Is(err, v.err):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		case err == nil && reflect.DeepEqual(result, v.result):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %s. Result: %s", i, v.input, v.result, result)
+		}
+	}
+}
+
+func TestMarshalXML(t *testing.T) {
+	input := X{A: NewDuration(0, 0, 1, 1, 0, 0, 0, false), D: NewDuration(0, 13, 0, 0, 0, 0, 0, true)}
+	result := `<x a="P8D"><d>-P1Y1M</d></x>`
+
+	body, err := xml.Marshal(input)
+
+	switch {
+	case err == nil && string(body) == result:
+		t.Logf("Test (input: %v) completed successfully", input)
+	default:
+		t.Errorf("Test (input: %v) failed. Expected: %s. Result: %s", input, result, body)
+	}
+
+	if _, err := xml.Marshal(X{A: NewDuration(0, 0.5, 0, 0, 0, 0, 0, false)}); err == nil {
+		t.Errorf("Test (input: P0.5M) failed. Expected an error")
+	}
+}
+
+func TestUnmarshalXML(t *testing.T) {
+	tests := []struct {
+		input   []byte
+		result  X
+		isError bool
+	}{
+		{
+			input:  []byte(`<x a="P8D"><d> -P1Y1M </d></x>`),
+			result: X{XMLName: xml.Name{Local: "x"}, A: NewDuration(0, 0, 8, 0, 0, 0, 0, false), D: NewDuration(1, 1, 0, 0, 0, 0, 0, true)},
+		},
+		{
+			input:   []byte(`<x a="P1W"></x>`),
+			isError: true,
+		},
+		{
+			input:   []byte(`<x><d>P</d></x>`),
+			isError: true,
+		},
+	}
+
+	for i, v := range tests {
+		x := &X{}
+		err := xml.Unmarshal(v.input, x)
+
+		switch {
+		case (err != nil && v.isError) || (err == nil && reflect.DeepEqual(x, &v.result)):
+			t.Logf("Test %d (input: %s) completed successfully", i, v.input)
+		default:
+			t.Errorf("Test %d (input: %s) failed. Expected: %v. Result: %v", i, v.input, v.result, x)
+		}
+	}
+}